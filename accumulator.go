@@ -0,0 +1,71 @@
+// SPDX-License-Group: MIT
+//
+// Copyright (C) 2022 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package decaf448
+
+import "github.com/bytemare/decaf448/edwards448"
+
+// Accumulator holds a running sum of elements. The sum is kept in extended coordinates, and is only converted back to
+// an element by Result.
+type Accumulator struct {
+	sum edwards448.Point
+}
+
+// NewAccumulator returns an accumulator set to the identity element.
+func NewAccumulator() *Accumulator {
+	var a Accumulator
+	a.sum.Identity()
+
+	return &a
+}
+
+// Reset sets the running sum back to the identity element, so that a can be reused.
+func (a *Accumulator) Reset() *Accumulator {
+	a.sum.Identity()
+	return a
+}
+
+// Add adds e to the running sum. The zero value of DecafElement, which does not represent a point, counts as the
+// identity element.
+func (a *Accumulator) Add(e *DecafElement) *Accumulator {
+	a.sum.Add(operand(e))
+	return a
+}
+
+// Sub subtracts e from the running sum. The zero value of DecafElement counts as the identity element.
+func (a *Accumulator) Sub(e *DecafElement) *Accumulator {
+	a.sum.Subtract(operand(e))
+	return a
+}
+
+// operand returns a copy of the point of e, replaced by the identity if its Z coordinate is 0, as in the zero value.
+// Adding such a point would otherwise set Z = 0 in the running sum, which then compares equal to any point.
+func operand(e *DecafElement) *edwards448.Point {
+	var p edwards448.Point
+	invalid := e.p.Z.IsZero()
+
+	p.X.Set(&e.p.X)
+	p.Y.SelectCT(one, &e.p.Y, invalid)
+	p.T.Set(&e.p.T)
+	p.Z.SelectCT(one, &e.p.Z, invalid)
+
+	return &p
+}
+
+// IsIdentity returns whether the running sum is the identity element.
+func (a *Accumulator) IsIdentity() bool {
+	return a.sum.IsInfinity() == 1
+}
+
+// Result returns a new element set to the running sum.
+func (a *Accumulator) Result() *DecafElement {
+	e := NewGroupElement()
+	e.p.Set(&a.sum)
+
+	return e
+}
@@ -0,0 +1,145 @@
+// SPDX-License-Group: MIT
+//
+// Copyright (C) 2022 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package decaf448
+
+import (
+	"crypto/rand"
+	"testing"
+)
+
+func benchElement(b *testing.B) *DecafElement {
+	input := make([]byte, 112)
+	if _, err := rand.Read(input); err != nil {
+		b.Fatal(err)
+	}
+
+	return NewGroupElement().OneWayMap(input)
+}
+
+func BenchmarkEncode(b *testing.B) {
+	e := benchElement(b)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		e.encoded.Store(false)
+		e.Encode()
+	}
+}
+
+func BenchmarkEncodeCached(b *testing.B) {
+	e := benchElement(b)
+	e.Encode()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		e.Encode()
+	}
+}
+
+func BenchmarkDecode(b *testing.B) {
+	encoded := benchElement(b).Encode()
+	e := NewGroupElement()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		if err := e.decode(encoded); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDecodeEncode(b *testing.B) {
+	encoded := benchElement(b).Encode()
+	e := NewGroupElement()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		if err := e.decode(encoded); err != nil {
+			b.Fatal(err)
+		}
+
+		e.Encode()
+	}
+}
+
+func BenchmarkEncodeParallel(b *testing.B) {
+	p := benchElement(b).p
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	b.RunParallel(func(pb *testing.PB) {
+		e := NewGroupElement()
+		e.p.Set(&p)
+
+		for pb.Next() {
+			e.encoded.Store(false)
+			e.Encode()
+		}
+	})
+}
+
+func BenchmarkDecodeParallel(b *testing.B) {
+	encoded := benchElement(b).Encode()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	b.RunParallel(func(pb *testing.PB) {
+		e := NewGroupElement()
+		for pb.Next() {
+			if err := e.decode(encoded); err != nil {
+				b.Error(err)
+				return
+			}
+		}
+	})
+}
+
+func BenchmarkOneWayMap(b *testing.B) {
+	input := make([]byte, 112)
+	if _, err := rand.Read(input); err != nil {
+		b.Fatal(err)
+	}
+
+	e := NewGroupElement()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		e.OneWayMap(input)
+	}
+}
+
+func BenchmarkDecodeElements(b *testing.B) {
+	elements := make([]*DecafElement, 16)
+	for i := range elements {
+		elements[i] = benchElement(b)
+	}
+
+	encoded := EncodeElements(elements)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		if _, err := DecodeElements(encoded); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -8,35 +8,138 @@
 
 // Package decaf448 implements the Decaf448 group of prime order
 //
-//		l = 2^446 - 13818066809895115352007386748515426880336692474882178609894547503885
+//	l = 2^446 - 13818066809895115352007386748515426880336692474882178609894547503885
 //
-// as specified in https://datatracker.ietf.org/doc/draft-irtf-cfrg-ristretto255-decaf448.
+// as specified in RFC 9496, https://www.rfc-editor.org/rfc/rfc9496.
 package decaf448
 
 import (
-	"errors"
-	"math/big"
+	"bytes"
+	"crypto/sha3"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"github.com/bytemare/decaf448/edwards448"
+)
+
+const (
+	encodingLength     = 56
+	uniformBytesLength = 112
+
+	// mapToGroupLabel domain separates the input expansion in MapToGroup.
+	mapToGroupLabel = "decaf448_MapToGroup"
+)
+
+var (
+	errOutOfOrder    = fmt.Errorf("%w: out of order", ErrNonCanonical)
+	errNegative      = fmt.Errorf("%w: negative", ErrNonCanonical)
+	errNotSquare     = fmt.Errorf("%w: not square", ErrNotOnGroup)
+	errInvalidAffine = fmt.Errorf("%w: invalid affine coordinates", ErrNotOnGroup)
 )
 
 type DecafElement struct {
-	p Point
+	p edwards448.Point
+
+	// encoding caches the canonical encoding of p once computed, and is only valid if encoded is set. Every method
+	// modifying p must reset encoded. Methods that only read e may fill the cache from several goroutines, so they go
+	// through cachedEncoding, which serializes filling with mu and sets encoded only once encoding is written.
+	mu       sync.Mutex
+	encoding [encodingLength]byte
+	encoded  atomic.Bool
 }
 
+// NewGroupElement returns a new element set to the identity element.
 func NewGroupElement() *DecafElement {
 	var e DecafElement
+	e.p.Identity()
+
 	return &e
 }
 
+// Reset sets e to the identity element and clears its cached encoding, so that e can be reused. The backing arrays of
+// its coordinates are overwritten first, so that e no longer holds copies of a secret element.
+func (e *DecafElement) Reset() *DecafElement {
+	for _, c := range []*edwards448.Element{&e.p.X, &e.p.Y, &e.p.Z, &e.p.T} {
+		c.Wipe()
+	}
+
+	clear(e.encoding[:])
+	e.encoded.Store(false)
+	e.p.Identity()
+
+	return e
+}
+
 var (
-	oneMinusD, _     = newElement().SetString("39082", 10)
-	oneMinusTwoD, _  = newElement().SetString("78163", 10)
-	sqrtMinusD, _    = newElement().SetString("98944233647732219769177004876929019128417576295529901074099889598043702116001257856802131563896515373927712232092845883226922417596214", 10)
-	invSqrtMinusD, _ = newElement().SetString("315019913931389607337177038330951043522456072897266928557328499619017160722351061360252776265186336876723201881398623946864393857820716", 10)
-	// D = -39081
-	D, _ = newElement().SetString("726838724295606890549323807888004534353641360687318060281490199180612328166730772686396383698676545930088884461843637361053498018326358", 10)
+	// generatorEncoding is the canonical encoding of the decaf448 generator.
+	generatorEncoding = append(bytes.Repeat([]byte{0x66}, 28), bytes.Repeat([]byte{0x33}, 28)...)
+	generator         = MustDecodeElement(generatorEncoding)
+
+	one = edwards448.NewElement().One()
+	two = edwards448.NewElement().SetUint64(2)
+
+	// fourD = 4 * D
+	fourD = edwards448.NewElement().Multiply(edwards448.NewElement().SetUint64(4), edwards448.D)
+
+	oneMinusD        = edwards448.NewElement().SetUint64(39082)
+	sqrtMinusD, _    = edwards448.NewElement().SetString("98944233647732219769177004876929019128417576295529901074099889598043702116001257856802131563896515373927712232092845883226922417596214", 10)
+	invSqrtMinusD, _ = edwards448.NewElement().SetString("315019913931389607337177038330951043522456072897266928557328499619017160722351061360252776265186336876723201881398623946864393857820716", 10)
 )
 
-func (e *DecafElement) Encode() []byte {
+// temporaries holds the field elements used by encode and decode. They are pooled, so that the big.Int backing
+// arrays are reused across calls instead of being reallocated. Every field is overwritten before being read, and wiped
+// before being put back.
+type temporaries struct {
+	s, ss, u1, u2, u22, u3, ratio, invsqrt, x, y, t edwards448.Element
+}
+
+func (t *temporaries) wipe() {
+	for _, e := range []*edwards448.Element{
+		&t.s, &t.ss, &t.u1, &t.u2, &t.u22, &t.u3, &t.ratio, &t.invsqrt, &t.x, &t.y, &t.t,
+	} {
+		e.Wipe()
+	}
+}
+
+func getTemporaries() *temporaries {
+	return temporariesPool.Get().(*temporaries)
+}
+
+func putTemporaries(tmp *temporaries) {
+	tmp.wipe()
+	temporariesPool.Put(tmp)
+}
+
+var temporariesPool = sync.Pool{
+	New: func() any {
+		return new(temporaries)
+	},
+}
+
+// Encode returns the 56-byte canonical encoding of e. The encoding is computed once and cached in e. Like the other
+// encoding methods, it can be called concurrently on an element that is not being modified.
+func (e *DecafElement) Encode() ElementEncoding {
+	out := make([]byte, encodingLength)
+	copy(out, e.cachedEncoding()[:])
+
+	return out
+}
+
+// cachedEncoding returns the cached canonical encoding of e, and computes it first if needed.
+func (e *DecafElement) cachedEncoding() *[encodingLength]byte {
+	if !e.encoded.Load() {
+		e.mu.Lock()
+		if !e.encoded.Load() {
+			e.encode()
+		}
+		e.mu.Unlock()
+	}
+
+	return &e.encoding
+}
+
+func (e *DecafElement) encode() {
 	/*
 		A group element with internal representation (x0, y0, z0, t0) is
 		   encoded as follows:
@@ -59,33 +162,67 @@ func (e *DecafElement) Encode() []byte {
 		   yield an identical byte string.
 	*/
 
-	var u1, u2, ratio, s Element
+	tmp := getTemporaries()
+	defer putTemporaries(tmp)
+
+	u1, u2, ratio, s, invsqrt := &tmp.u1, &tmp.u2, &tmp.ratio, &tmp.s, &tmp.invsqrt
 	u1.Add(&e.p.X, &e.p.T)
 	u2.Subtract(&e.p.X, &e.p.T)
-	u1.Multiply(&u1, &u2)
+	u1.Multiply(u1, u2)
 
 	u2.Square(&e.p.X)
-	u2.Multiply(&u2, oneMinusD)
-	u2.Multiply(&u2, &u1)
-	_, invsqrt := newElement().SqrtRatio(one, &u2)
+	u2.Multiply(u2, oneMinusD)
+	u2.Multiply(u2, u1)
+	invsqrt.SqrtRatio(one, u2)
 
-	ratio.Multiply(invsqrt, &u1)
-	ratio.Multiply(&ratio, sqrtMinusD)
-	ratio.AbsoluteCT(&ratio)
+	ratio.Multiply(invsqrt, u1)
+	ratio.Multiply(ratio, sqrtMinusD)
+	ratio.AbsoluteCT(ratio)
 
-	u2.Multiply(invSqrtMinusD, &ratio)
-	u2.Multiply(&u2, &e.p.Z)
-	u2.Subtract(&u2, &e.p.T)
+	u2.Multiply(invSqrtMinusD, ratio)
+	u2.Multiply(u2, &e.p.Z)
+	u2.Subtract(u2, &e.p.T)
 
 	s.Multiply(oneMinusD, invsqrt)
-	s.Multiply(&s, &e.p.X)
-	s.Multiply(&s, &u2)
-	s.AbsoluteCT(&s)
+	s.Multiply(s, &e.p.X)
+	s.Multiply(s, u2)
+	s.AbsoluteCT(s)
+
+	copy(e.encoding[:], s.BytesLittle())
+	e.encoded.Store(true)
+}
 
-	return reverse(s.int.Bytes())
+// Decode sets e to the decoding of input, and panics if input is not a valid encoding.
+func (e *DecafElement) Decode(input ElementEncoding) *DecafElement {
+	if err := e.decode(input); err != nil {
+		panic(err)
+	}
+
+	return e
+}
+
+// DecodeElement returns a new element decoded from input, and an error if input is not a valid encoding.
+func DecodeElement(input ElementEncoding) (*DecafElement, error) {
+	e := NewGroupElement()
+	if err := e.decode(input); err != nil {
+		return nil, err
+	}
+
+	return e, nil
+}
+
+// MustDecodeElement is like DecodeElement but panics on invalid input. It is meant for known-good constants, e.g. in
+// package-level variables and tests.
+func MustDecodeElement(input ElementEncoding) *DecafElement {
+	e, err := DecodeElement(input)
+	if err != nil {
+		panic(err)
+	}
+
+	return e
 }
 
-func (e *DecafElement) Decode(input []byte) *DecafElement {
+func (e *DecafElement) decode(input []byte) error {
 	/*
 		All elements are encoded as a 56-byte string.  Decoding proceeds as
 		   follows:
@@ -115,160 +252,125 @@ func (e *DecafElement) Decode(input []byte) *DecafElement {
 		       the group element represented by the internal representation (x,
 		       y, 1, t).
 	*/
-	if len(input) != 56 {
-		panic(errors.New("invalid length"))
+	if len(input) != encodingLength {
+		return ErrInvalidLength
 	}
 
-	s, _ := newElement().SetBytesLittle(input)
+	tmp := getTemporaries()
+	defer putTemporaries(tmp)
 
-	if curveOrder.Compare(s) != 1 {
-		panic(errors.New("out of order"))
+	s := &tmp.s
+	if _, err := s.SetCanonicalBytes(input); err != nil {
+		return errOutOfOrder
 	}
 
 	if s.IsNegative() == 1 {
-		panic(errors.New("negative"))
+		return errNegative
 	}
 
-	var ss, u1, u2, u22, u3, t, x, y Element
-	four := newElement().SetInt(big.NewInt(4))
+	ss, u1, u2, u22, u3, invsqrt := &tmp.ss, &tmp.u1, &tmp.u2, &tmp.u22, &tmp.u3, &tmp.invsqrt
+	x, y, t := &tmp.x, &tmp.y, &tmp.t
 
 	// ss = s^2
 	// u1 = 1 + ss
 	ss.Square(s)
-	u1.Add(&ss, one)
+	u1.Add(ss, one)
 
 	// u2 = u1^2 - 4 * D * ss
-	u2.Multiply(&u1, &u1)
-	u22.Multiply(four, D)
-	u22.Multiply(&u22, &ss)
-	u2.Subtract(&u2, &u22)
+	u2.Multiply(u1, u1)
+	u22.Multiply(fourD, ss)
+	u2.Subtract(u2, u22)
 
 	// (was_square, invsqrt) = SQRT_RATIO_M1(1, u2 * u1^2)
-	u22.Multiply(&u1, &u1)
-	wasSquare, invsqrt := newElement().SqrtRatio(one, u22.Multiply(&u2, &u22))
+	u22.Multiply(u1, u1)
+	wasSquare, _ := invsqrt.SqrtRatio(one, u22.Multiply(u2, u22))
 
 	// u3 = CT_ABS(2 * s * invsqrt * u1 * SQRT_MINUS_D)
 	u3.Multiply(two, s)
-	u3.Multiply(&u3, invsqrt)
-	u3.Multiply(&u3, &u1)
-	u3.Multiply(&u3, sqrtMinusD)
-	u3.AbsoluteCT(&u3)
+	u3.Multiply(u3, invsqrt)
+	u3.Multiply(u3, u1)
+	u3.Multiply(u3, sqrtMinusD)
+	u3.AbsoluteCT(u3)
 
 	// x = u3 * invsqrt * u2 * INVSQRT_MINUS_D
-	x.Multiply(&u3, invsqrt)
-	x.Multiply(&x, &u2)
-	x.Multiply(&x, invSqrtMinusD)
+	x.Multiply(u3, invsqrt)
+	x.Multiply(x, u2)
+	x.Multiply(x, invSqrtMinusD)
 
 	// y = (1 - ss) * invsqrt * u1
-	y.Subtract(one, &ss)
-	y.Multiply(&y, invsqrt)
-	y.Multiply(&y, &u1)
+	y.Subtract(one, ss)
+	y.Multiply(y, invsqrt)
+	y.Multiply(y, u1)
 
-	t.Multiply(&x, &y)
+	t.Multiply(x, y)
 
 	if !(wasSquare == 1) {
-		panic(errors.New("not square"))
+		return errNotSquare
 	}
 
-	e.p.X.Set(&x)
-	e.p.Y.Set(&y)
-	e.p.T.Set(&t)
+	e.p.X.Set(x)
+	e.p.Y.Set(y)
+	e.p.T.Set(t)
 	e.p.Z.Set(one)
 
-	return e
+	// A valid input is the canonical encoding of the element, so it seeds the cache and re-encoding is free.
+	copy(e.encoding[:], input)
+	e.encoded.Store(true)
+
+	return nil
 }
 
-func (e *DecafElement) OneWayMap(input []byte) *DecafElement {
-	v := make([]byte, len(input))
-	copy(v, input)
-	v = reverse(v)
+// MapToGroup sets e to the element derived from input of any length, by expanding input to 112 uniform bytes with
+// SHAKE256 and applying SetUniformBytes. It does not implement hash-to-group with a domain separation tag, which
+// protocols should use instead.
+func (e *DecafElement) MapToGroup(input []byte) *DecafElement {
+	var uniform [uniformBytesLength]byte
 
-	p1 := _map(v[:56])
-	p2 := _map(v[56:112])
-	e.p.Set(p1.Add(p2))
+	h := sha3.NewSHAKE256()
+	_, _ = h.Write([]byte(mapToGroupLabel))
+	_, _ = h.Write(input)
+	_, _ = h.Read(uniform[:])
 
-	return e
+	return e.SetUniformBytes(uniform[:])
 }
 
-func _map(input []byte) *Point {
-	/*
-		The MAP function is defined on a 56-byte string as:
-
-		   1.  Interpret the string as an integer r in little-endian
-		       representation.  Reduce r modulo p to obtain a field element t.
+// SetUniformBytes sets e to the element derived from 112 uniformly random bytes, with the element derivation function
+// of RFC 9496 (the one-way map of earlier drafts). It panics if input is not 112 bytes long.
+func (e *DecafElement) SetUniformBytes(input []byte) *DecafElement {
+	if len(input) != uniformBytesLength {
+		panic(ErrInvalidLength)
+	}
 
-		       *  Note: similarly to [RFC7748] field element decoding, and
-		          unlike field element decoding in Section 5.3.1, non-canonical
-		          values are accepted.
+	p1 := _map(input[:encodingLength])
+	p2 := _map(input[encodingLength:])
+	e.p.Set(p1.Add(p2))
+	e.encoded.Store(false)
 
-		   2.  Process t as follows:
+	return e
+}
 
-		   r = -t^2
-		   u0 = d * (r-1)
-		   u1 = (u0 + 1) * (u0 - r)
+// OneWayMap sets e to the element derived from the 112-byte input.
+//
+// Deprecated: use SetUniformBytes. Like it, OneWayMap now panics on input longer than 112 bytes, where it previously
+// ignored the excess.
+func (e *DecafElement) OneWayMap(input []byte) *DecafElement {
+	return e.SetUniformBytes(input)
+}
 
-		   (was_square, v) = SQRT_RATIO_M1(ONE_MINUS_TWO_D, (r + 1) * u1)
-		   v_prime = CT_SELECT(v IF was_square ELSE t * v)
-		   sgn     = CT_SELECT(1 IF was_square ELSE -1)
-		   s = v_prime * (r + 1)
+// Equal returns 1 if e and u represent the same element, and 0 otherwise. Following RFC 9496, the representations
+// (x1, y1) and (x2, y2) are equivalent if and only if x1 * y2 == y1 * x2. It does not branch on the values.
+func (e *DecafElement) Equal(u *DecafElement) int {
+	var a, b edwards448.Element
+	a.Multiply(&e.p.X, &u.p.Y)
+	b.Multiply(&e.p.Y, &u.p.X)
 
-		   w0 = 2 * CT_ABS(s)
-		   w1 = s^2 + 1
-		   w2 = s^2 - 1
-		   w3 = v_prime * s * (r - 1) * ONE_MINUS_TWO_D + sgn
+	return a.IsEqualCT(&b)
+}
 
-		   3.  Return the group element represented by the internal
-		       representation (w0*w3, w2*w1, w1*w3, w0*w2).
-	*/
+// _map implements the MAP function on a 56-byte string, which is reduced modulo p and mapped with the Elligator map.
+func _map(input []byte) *edwards448.Point {
+	var t edwards448.Element
+	_, _ = t.SetBytesLittle(input)
 
-	r, _ := newElement().SetBytesBig(input)
-	t := newElement().reduce(&r.int, &curveOrder.int)
-
-	var u0, u01, u0r, u1, rMinOne, rPlusOne Element
-
-	// r = -t^2
-	//	   u0 = d * (r-1)
-	//	   u1 = (u0 + 1) * (u0 - r)
-	r.Square(t)
-	r.Negate(r)
-	rMinOne.Subtract(r, one)
-	u0.Multiply(D, &rMinOne)
-	u01.Add(&u0, one)
-	u0r.Subtract(&u0, r)
-	u1.Multiply(&u01, &u0r)
-
-	// (was_square, v) = SQRT_RATIO_M1(ONE_MINUS_TWO_D, (r + 1) * u1)
-	//	   v_prime = CT_SELECT(v IF was_square ELSE t * v)
-	//	   sgn     = CT_SELECT(1 IF was_square ELSE -1)
-	//	   s = v_prime * (r + 1)
-	var vPrime, sgn, s Element
-	rPlusOne.Add(r, one)
-	u1.Multiply(&u1, &rPlusOne)
-	wasSquare, v := newElement().SqrtRatio(oneMinusTwoD, &u1)
-	vPrime.SelectCT(v, newElement().Multiply(t, v), wasSquare)
-	sgn.SelectCT(one, minusOne, wasSquare)
-	s.Multiply(&vPrime, &rPlusOne)
-
-	// w0 = 2 * CT_ABS(s)
-	//	   w1 = s^2 + 1
-	//	   w2 = s^2 - 1
-	//	   w3 = v_prime * s * (r - 1) * ONE_MINUS_TWO_D + sgn
-	var w0, w1, w2, w3 Element
-	w0.Multiply(two, newElement().AbsoluteCT(&s))
-	w1.Square(&s)
-	w1.Add(&s, one)
-	w2.Square(&s)
-	w2.Subtract(&w2, one)
-	w3.Multiply(&vPrime, &s)
-	w3.Multiply(&w3, &rMinOne)
-	w3.Multiply(&w3, oneMinusTwoD)
-	w3.Add(&w3, &sgn)
-
-	var p Point
-	p.X.Multiply(&w0, &w3)
-	p.Y.Multiply(&w2, &w1)
-	p.T.Multiply(&w0, &w2)
-	p.Z.Multiply(&w1, &w3)
-
-	return &p
+	return new(edwards448.Point).Elligator(&t)
 }
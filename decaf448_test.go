@@ -0,0 +1,285 @@
+// SPDX-License-Group: MIT
+//
+// Copyright (C) 2022 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package decaf448_test
+
+import (
+	"bytes"
+	"crypto/sha3"
+	"encoding/hex"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/bytemare/decaf448"
+)
+
+func TestEncodingCache(t *testing.T) {
+	e := randomElement(t)
+	encoded := e.Encode()
+
+	// Modifying the returned slice must not alter the cached encoding.
+	encoded[0] ^= 0xff
+	if bytes.Equal(encoded, e.Encode()) {
+		t.Fatal("cached encoding was modified through the returned slice")
+	}
+
+	encoded[0] ^= 0xff
+
+	// Mutating the element must invalidate the cache.
+	other := randomElement(t).Encode()
+	e.Decode(other)
+
+	if !bytes.Equal(other, e.Encode()) {
+		t.Fatal("cache not invalidated on Decode")
+	}
+
+	e.OneWayMap(make([]byte, 112))
+
+	if bytes.Equal(other, e.Encode()) || !bytes.Equal(e.Encode(), decaf448.NewGroupElement().OneWayMap(make([]byte, 112)).Encode()) {
+		t.Fatal("cache not invalidated on OneWayMap")
+	}
+}
+
+func TestConcurrentEncoding(t *testing.T) {
+	// An element that is not being modified can be encoded from several goroutines, also when its cache is empty.
+	// Run with -race.
+	e := decaf448.NewGroupElement().MapToGroup([]byte("concurrent"))
+	want := decaf448.NewGroupElement().MapToGroup([]byte("concurrent")).Encode()
+
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			if !bytes.Equal(e.Encode(), want) || e.EncodeHex() != hex.EncodeToString(want) {
+				t.Error("unexpected concurrent encoding")
+			}
+
+			_ = e.EncodedArray()
+		}()
+	}
+
+	wg.Wait()
+}
+
+func TestReset(t *testing.T) {
+	identity := decaf448.NewAccumulator().Result()
+
+	e := randomElement(t)
+	e.Encode()
+
+	if !bytes.Equal(e.Reset().Encode(), identity.Encode()) {
+		t.Fatal("Reset did not set the identity element")
+	}
+
+	// The reset element must behave as the identity in group operations.
+	a := randomElement(t)
+	if !bytes.Equal(decaf448.NewAccumulator().Add(e).Add(a).Result().Encode(), a.Encode()) {
+		t.Fatal("reset element is not neutral")
+	}
+
+	acc := decaf448.NewAccumulator().Add(a)
+	if !acc.Reset().IsIdentity() {
+		t.Fatal("Reset did not clear the accumulator")
+	}
+}
+
+func TestAccumulator(t *testing.T) {
+	a, b, c := randomElement(t), randomElement(t), randomElement(t)
+
+	acc := decaf448.NewAccumulator()
+	if !acc.IsIdentity() || !bytes.Equal(acc.Result().Encode(), make([]byte, 56)) {
+		t.Fatal("new accumulator is not the identity")
+	}
+
+	acc.Add(a).Add(b).Add(c).Sub(a).Sub(c)
+
+	if acc.IsIdentity() {
+		t.Fatal("unexpected identity")
+	}
+
+	if !bytes.Equal(acc.Result().Encode(), b.Encode()) {
+		t.Fatal("a + b + c - a - c != b")
+	}
+
+	if !acc.Sub(b).IsIdentity() {
+		t.Fatal("expected the identity")
+	}
+}
+
+func TestAccumulatorUnsetElement(t *testing.T) {
+	// A new element is the identity, and a zero value element counts as the identity, so neither can make a sum look
+	// like the identity.
+	for _, unset := range []*decaf448.DecafElement{decaf448.NewGroupElement(), new(decaf448.DecafElement)} {
+		acc := decaf448.NewAccumulator().Add(unset).Add(randomElement(t))
+		if acc.IsIdentity() {
+			t.Fatal("sum including an unset element is the identity")
+		}
+
+		if !decaf448.NewAccumulator().Sub(unset).IsIdentity() {
+			t.Fatal("unset element is not the identity")
+		}
+	}
+}
+
+func TestMapToGroup(t *testing.T) {
+	for _, input := range [][]byte{nil, {}, []byte("a"), make([]byte, 112), make([]byte, 1000)} {
+		e := decaf448.NewGroupElement().MapToGroup(input)
+
+		expected := decaf448.NewGroupElement().OneWayMap(sha3.SumSHAKE256(append([]byte("decaf448_MapToGroup"), input...), 112))
+		if !bytes.Equal(e.Encode(), expected.Encode()) {
+			t.Fatalf("unexpected output for input of length %d", len(input))
+		}
+
+		if _, err := decaf448.DecodeElements(decaf448.EncodeElements([]*decaf448.DecafElement{e})); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if bytes.Equal(decaf448.NewGroupElement().MapToGroup([]byte("a")).Encode(),
+		decaf448.NewGroupElement().MapToGroup([]byte("b")).Encode()) {
+		t.Fatal("different inputs mapped to the same element")
+	}
+}
+
+func TestEqualEncodings(t *testing.T) {
+	a, b := randomElement(t).Encode(), randomElement(t).Encode()
+
+	if decaf448.EqualEncodings(a, append([]byte{}, a...)) != 1 || decaf448.EqualEncodings(a, b) != 0 ||
+		decaf448.EqualEncodings(a, a[:55]) != 0 {
+		t.Fatal("unexpected EqualEncodings result")
+	}
+
+	fa := decaf448.MustDecodeElement(a).EncodedArray()
+	fb := fa
+
+	if decaf448.EqualFixedEncodings(&fa, &fb) != 1 {
+		t.Fatal("expected equal encodings")
+	}
+
+	fb[55] ^= 1
+	if decaf448.EqualFixedEncodings(&fa, &fb) != 0 {
+		t.Fatal("expected different encodings")
+	}
+}
+
+func TestEncodedElement(t *testing.T) {
+	a, b := randomElement(t), randomElement(t)
+
+	set := map[decaf448.EncodedElement]int{}
+	for _, e := range []*decaf448.DecafElement{a, b, a, decaf448.NewGroupElement().Decode(b.Encode())} {
+		set[e.EncodedArray()]++
+	}
+
+	if len(set) != 2 || set[a.EncodedArray()] != 2 || set[b.EncodedArray()] != 2 {
+		t.Fatal("unexpected map contents")
+	}
+
+	encoded := a.EncodedArray()
+	if !bytes.Equal(encoded.Bytes(), a.Encode()) {
+		t.Fatal("unexpected bytes")
+	}
+
+	d, err := encoded.Element()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if d.EncodedArray() != encoded {
+		t.Fatal("element differs after decoding")
+	}
+
+	encoded[0] |= 1
+	if _, err = encoded.Element(); err == nil {
+		t.Fatal("expected error on invalid encoding")
+	}
+}
+
+func TestSentinelErrors(t *testing.T) {
+	valid := randomElement(t).Encode()
+
+	negative := bytes.Clone(valid)
+	negative[0] |= 1
+
+	p := decaf448.FieldOrder()
+
+	for _, test := range []struct {
+		input    []byte
+		expected error
+	}{
+		{valid[:55], decaf448.ErrInvalidLength},
+		{negative, decaf448.ErrNonCanonical},
+		{p, decaf448.ErrNonCanonical},
+	} {
+		if err := decaf448.NewGroupElement().DecodeHex(hex.EncodeToString(test.input)); !errors.Is(err, test.expected) {
+			t.Fatalf("expected %v, got %v", test.expected, err)
+		}
+	}
+
+	// Decode panics with the same errors.
+	func() {
+		defer func() {
+			if err, _ := recover().(error); !errors.Is(err, decaf448.ErrNonCanonical) {
+				t.Fatalf("unexpected panic value %v", err)
+			}
+		}()
+
+		decaf448.NewGroupElement().Decode(negative)
+	}()
+
+	// DecodeTrusted errors wrap the same sentinels.
+	trusted := randomElement(t).EncodeTrusted()
+
+	corrupted := bytes.Clone(trusted)
+	corrupted[len(corrupted)-1] ^= 1
+
+	// A non-canonical T coordinate under a valid tag.
+	nonCanonical := append(bytes.Clone(trusted[:3*56]), p...)
+	nonCanonical = append(nonCanonical, sha3.SumSHAKE256(append([]byte("decaf448_TrustedEncoding"), nonCanonical...), 32)...)
+
+	for _, test := range []struct {
+		input    []byte
+		expected error
+	}{
+		{trusted[:len(trusted)-1], decaf448.ErrInvalidLength},
+		{corrupted, decaf448.ErrInvalidFormat},
+		{nonCanonical, decaf448.ErrNonCanonical},
+	} {
+		if err := decaf448.NewGroupElement().DecodeTrusted(test.input); !errors.Is(err, test.expected) {
+			t.Fatalf("DecodeTrusted: expected %v, got %v", test.expected, err)
+		}
+	}
+
+	if _, err := decaf448.DecodeElements([]byte{0, 0, 0, 1}); !errors.Is(err, decaf448.ErrInvalidLength) {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if _, err := decaf448.ECDH().NewPrivateKey(decaf448.Order()); !errors.Is(err, decaf448.ErrScalarOutOfRange) {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if _, err := decaf448.ECDH().NewPublicKey(make([]byte, 56)); !errors.Is(err, decaf448.ErrIdentity) {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	// About half of the canonical non-negative encodings do not decode to an element.
+	notOnGroup := make([]byte, 56)
+	for s := byte(2); ; s += 2 {
+		notOnGroup[0] = s
+		if err := decaf448.NewGroupElement().DecodeHex(hex.EncodeToString(notOnGroup)); err != nil {
+			if !errors.Is(err, decaf448.ErrNotOnGroup) {
+				t.Fatalf("unexpected error %v", err)
+			}
+
+			break
+		}
+	}
+}
@@ -0,0 +1,165 @@
+// SPDX-License-Group: MIT
+//
+// Copyright (C) 2022 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package decaf448
+
+import (
+	"crypto"
+	"fmt"
+	"io"
+
+	"github.com/bytemare/decaf448/internal/ct"
+)
+
+var (
+	errInvalidPrivateKey = fmt.Errorf("%w: invalid private key", ErrScalarOutOfRange)
+	errIdentityPublicKey = fmt.Errorf("%w: public key is the identity element", ErrIdentity)
+	errIdentityECDH      = fmt.Errorf("%w: ECDH output is the identity element", ErrIdentity)
+)
+
+// ECDHCurve implements Diffie-Hellman over decaf448 with the method set of the crypto/ecdh Curve, PrivateKey and
+// PublicKey types, so that code written against those can use decaf448. crypto/ecdh.Curve itself cannot be
+// implemented outside the standard library.
+//
+// Private keys are 56-byte little-endian scalars in [1, l-1], public keys are element encodings, and the shared
+// secret is the encoding of the shared element.
+type ECDHCurve struct{}
+
+var ecdhCurve = new(ECDHCurve)
+
+// ECDH returns the decaf448 ECDH curve.
+func ECDH() *ECDHCurve {
+	return ecdhCurve
+}
+
+// String returns "decaf448".
+func (c *ECDHCurve) String() string {
+	return "decaf448"
+}
+
+// GenerateKey generates a random private key, reading from rand.
+func (c *ECDHCurve) GenerateKey(rand io.Reader) (*PrivateKey, error) {
+	var key [encodingLength]byte
+
+	// l is a little below 2^446, so rejection sampling on 446 bits succeeds on the first try with overwhelming
+	// probability.
+	for {
+		if _, err := io.ReadFull(rand, key[:]); err != nil {
+			return nil, err
+		}
+
+		key[encodingLength-1] &= 0x3f
+
+		if k, err := c.NewPrivateKey(key[:]); err == nil {
+			return k, nil
+		}
+	}
+}
+
+// NewPrivateKey checks that key is a valid private key, and returns a PrivateKey.
+func (c *ECDHCurve) NewPrivateKey(key []byte) (*PrivateKey, error) {
+	if len(key) != encodingLength {
+		return nil, ErrInvalidLength
+	}
+
+	s, err := DecodeScalar(key)
+	if err != nil || s.IsZero() == 1 {
+		return nil, errInvalidPrivateKey
+	}
+
+	k := &PrivateKey{scalar: s}
+	copy(k.key[:], key)
+
+	pub := NewGroupElement().ScalarBaseMult(s)
+	k.public = &PublicKey{element: pub, key: pub.EncodedArray()}
+
+	return k, nil
+}
+
+// NewPublicKey checks that key is a valid element encoding other than the identity, and returns a PublicKey.
+func (c *ECDHCurve) NewPublicKey(key []byte) (*PublicKey, error) {
+	e := NewGroupElement()
+	if err := e.decode(key); err != nil {
+		return nil, err
+	}
+
+	pub := &PublicKey{element: e}
+	copy(pub.key[:], key)
+
+	if ct.Equal(pub.key[:], make([]byte, encodingLength)) == 1 {
+		return nil, errIdentityPublicKey
+	}
+
+	return pub, nil
+}
+
+// PrivateKey is a decaf448 ECDH private key.
+type PrivateKey struct {
+	scalar *Scalar
+	public *PublicKey
+	key    [encodingLength]byte
+}
+
+// Bytes returns a copy of the encoding of the private key.
+func (k *PrivateKey) Bytes() []byte {
+	return append([]byte(nil), k.key[:]...)
+}
+
+// Curve returns the curve of the key.
+func (k *PrivateKey) Curve() *ECDHCurve {
+	return ecdhCurve
+}
+
+// ECDH returns the encoding of the shared element, and returns an error if it is the identity, which cannot happen
+// with valid keys.
+func (k *PrivateKey) ECDH(remote *PublicKey) ([]byte, error) {
+	out := NewGroupElement().ScalarMult(k.scalar, remote.element).Encode()
+	if ct.Equal(out, make([]byte, encodingLength)) == 1 {
+		return nil, errIdentityECDH
+	}
+
+	return out, nil
+}
+
+// Equal returns whether x represents the same private key as k, in constant time.
+func (k *PrivateKey) Equal(x crypto.PrivateKey) bool {
+	xx, ok := x.(*PrivateKey)
+	return ok && ct.Equal(k.key[:], xx.key[:]) == 1
+}
+
+// Public implements the crypto.Signer-style accessor, and returns the same value as PublicKey.
+func (k *PrivateKey) Public() crypto.PublicKey {
+	return k.public
+}
+
+// PublicKey returns the public key corresponding to k.
+func (k *PrivateKey) PublicKey() *PublicKey {
+	return k.public
+}
+
+// PublicKey is a decaf448 ECDH public key.
+type PublicKey struct {
+	element *DecafElement
+	key     [encodingLength]byte
+}
+
+// Bytes returns a copy of the encoding of the public key.
+func (k *PublicKey) Bytes() []byte {
+	return append([]byte(nil), k.key[:]...)
+}
+
+// Curve returns the curve of the key.
+func (k *PublicKey) Curve() *ECDHCurve {
+	return ecdhCurve
+}
+
+// Equal returns whether x represents the same public key as k.
+func (k *PublicKey) Equal(x crypto.PublicKey) bool {
+	xx, ok := x.(*PublicKey)
+	return ok && ct.Equal(k.key[:], xx.key[:]) == 1
+}
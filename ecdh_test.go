@@ -0,0 +1,217 @@
+// SPDX-License-Group: MIT
+//
+// Copyright (C) 2022 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package decaf448_test
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"testing"
+
+	"github.com/bytemare/decaf448"
+)
+
+// rfcB1 and rfcB2 are the encodings of the generator and its double, B[1] and B[2] in RFC 9496, Appendix A.2.
+var (
+	rfcB1, _ = hex.DecodeString(
+		"6666666666666666666666666666666666666666666666666666666633333333333333333333333333333333333333333333333333333333",
+	)
+	rfcB2, _ = hex.DecodeString(
+		"c898eb4f87f97c564c6fd61fc7e49689314a1f818ec85eeb3bd5514ac816d38778f69ef347a89fca817e66defdedce178c7cc709b2116e75",
+	)
+)
+
+func TestECDH(t *testing.T) {
+	curve := decaf448.ECDH()
+
+	alice, err := curve.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bob, err := curve.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bobPub, err := curve.NewPublicKey(bob.PublicKey().Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s1, err := alice.ECDH(bobPub)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s2, err := bob.ECDH(alice.PublicKey())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(s1, s2) {
+		t.Fatal("shared secrets differ")
+	}
+
+	if !bobPub.Equal(bob.Public()) || alice.PublicKey().Equal(bobPub) {
+		t.Fatal("unexpected public key equality")
+	}
+
+	restored, err := curve.NewPrivateKey(alice.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !restored.Equal(alice) || restored.Equal(bob) || !restored.PublicKey().Equal(alice.PublicKey()) {
+		t.Fatal("unexpected private key equality")
+	}
+
+	// The public key of 1 is the generator.
+	one := make([]byte, 56)
+	one[0] = 1
+
+	k, err := curve.NewPrivateKey(one)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(k.PublicKey().Bytes(), rfcB1) {
+		t.Fatal("unexpected public key for 1")
+	}
+
+	// The public key of 2, and the shared secret of 2 and the generator, are 2 * G.
+	two := make([]byte, 56)
+	two[0] = 2
+
+	k2, err := curve.NewPrivateKey(two)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(k2.PublicKey().Bytes(), rfcB2) {
+		t.Fatal("unexpected public key for 2")
+	}
+
+	shared, err := k2.ECDH(k.PublicKey())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(shared, rfcB2) {
+		t.Fatal("unexpected shared secret for 2 and G")
+	}
+}
+
+func TestECDHInvalidKeys(t *testing.T) {
+	curve := decaf448.ECDH()
+
+	for _, key := range [][]byte{nil, make([]byte, 55), make([]byte, 56), decaf448.Order(), bytes.Repeat([]byte{0xff}, 56)} {
+		if _, err := curve.NewPrivateKey(key); err == nil {
+			t.Fatalf("expected error for private key %x", key)
+		}
+	}
+
+	invalid := randomElement(t).Encode()
+	invalid[0] |= 1
+
+	for _, key := range [][]byte{nil, make([]byte, 56), invalid} {
+		if _, err := curve.NewPublicKey(key); err == nil {
+			t.Fatalf("expected error for public key %x", key)
+		}
+	}
+}
+
+func TestSealedBox(t *testing.T) {
+	curve := decaf448.ECDH()
+
+	recipient, err := curve.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	other, err := curve.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, msg := range [][]byte{nil, []byte("message")} {
+		box, err := decaf448.Seal(recipient.PublicKey(), msg)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(box) != len(msg)+72 {
+			t.Fatalf("unexpected box length %d", len(box))
+		}
+
+		opened, err := decaf448.Open(recipient, box)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !bytes.Equal(opened, msg) {
+			t.Fatal("unexpected message")
+		}
+
+		if _, err = decaf448.Open(other, box); err == nil {
+			t.Fatal("box opened with the wrong key")
+		}
+
+		for _, i := range []int{0, 56, len(box) - 1} {
+			tampered := bytes.Clone(box)
+			tampered[i] ^= 2
+
+			if _, err = decaf448.Open(recipient, tampered); err == nil {
+				t.Fatalf("tampered box at %d opened", i)
+			}
+		}
+
+		if _, err = decaf448.Open(recipient, box[:71]); err == nil {
+			t.Fatal("truncated box opened")
+		}
+	}
+
+	box1, _ := decaf448.Seal(recipient.PublicKey(), []byte("message"))
+	box2, _ := decaf448.Seal(recipient.PublicKey(), []byte("message"))
+
+	if bytes.Equal(box1, box2) {
+		t.Fatal("sealing is deterministic")
+	}
+}
+
+func TestHedgedReader(t *testing.T) {
+	seed, aux := []byte("seed"), []byte("aux")
+
+	read := func(seed, aux []byte) []byte {
+		r, err := decaf448.NewHedgedReader(seed, aux)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		out := make([]byte, 64)
+		if _, err = r.Read(out); err != nil {
+			t.Fatal(err)
+		}
+
+		return out
+	}
+
+	if bytes.Equal(read(seed, aux), read(seed, aux)) || bytes.Equal(read(nil, nil), read(nil, nil)) {
+		t.Fatal("hedged output is not randomized")
+	}
+
+	r, err := decaf448.NewHedgedReader(seed, aux)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = decaf448.ECDH().GenerateKey(r); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,109 @@
+// SPDX-License-Group: MIT
+//
+// Copyright (C) 2022 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package decaf448
+
+import (
+	"crypto/sha3"
+	"fmt"
+	"math/big"
+
+	"github.com/bytemare/decaf448/edwards448"
+)
+
+const (
+	// Ed448PublicKeyLength is the length of an RFC 8032 Ed448 public key.
+	Ed448PublicKeyLength = 57
+
+	// Ed448SeedLength is the length of an RFC 8032 Ed448 private key, the seed the secret scalar is derived from.
+	Ed448SeedLength = 57
+
+	ed448HashLength = 114
+)
+
+var (
+	errEd448NonCanonical = fmt.Errorf("%w: Ed448 public key", ErrNonCanonical)
+	errEd448NotOnCurve   = fmt.Errorf("%w: Ed448 public key is not on the curve", ErrNotOnGroup)
+	errEd448Identity     = fmt.Errorf("%w: Ed448 public key has small order", ErrIdentity)
+)
+
+/*
+	The decaf448 generator is the class of [2]B, where B is the Ed448 base point of RFC 8032. An Ed448 key pair with
+	secret scalar s and public point A = [s]B therefore corresponds to the decaf448 scalar s mod l and the element
+	[2]A = [s]G. The converse does not hold: an element only determines [2]A up to 4-torsion, so decaf448 keys cannot be
+	turned back into Ed448 keys.
+*/
+
+// ElementFromEd448PublicKey returns the element corresponding to the RFC 8032 Ed448 public key pub, i.e. [s]G for the
+// secret scalar s of the key. It returns an error if pub is not a canonical encoding of a curve point, or if the point
+// has small order and would map to the identity element.
+func ElementFromEd448PublicKey(pub []byte) (*DecafElement, error) {
+	if len(pub) != Ed448PublicKeyLength {
+		return nil, ErrInvalidLength
+	}
+
+	// The last byte only holds the sign of x in its top bit.
+	if pub[Ed448PublicKeyLength-1]&0x7f != 0 {
+		return nil, errEd448NonCanonical
+	}
+
+	var y edwards448.Element
+	if _, err := y.SetCanonicalBytes(pub[:edwards448.ElementLength]); err != nil {
+		return nil, errEd448NonCanonical
+	}
+
+	// x^2 = (y^2 - 1) / (D * y^2 - 1)
+	var u, v, x edwards448.Element
+	u.Square(&y)
+	v.Multiply(edwards448.D, &u)
+	u.Subtract(&u, one)
+	v.Subtract(&v, one)
+
+	if wasSquare, _ := x.SqrtRatio(&u, &v); wasSquare != 1 {
+		return nil, errEd448NotOnCurve
+	}
+
+	sign := int(pub[Ed448PublicKeyLength-1] >> 7)
+	if sign == 1 && x.IsZero() == 1 {
+		return nil, errEd448NonCanonical
+	}
+
+	x.SelectCT(edwards448.NewElement().Negate(&x), &x, sign)
+
+	e := NewGroupElement()
+	e.p.X.Set(&x)
+	e.p.Y.Set(&y)
+	e.p.Z.Set(one)
+	e.p.T.Multiply(&x, &y)
+	e.p.Double()
+
+	if e.p.IsInfinity() == 1 {
+		return nil, errEd448Identity
+	}
+
+	return e, nil
+}
+
+// ScalarFromEd448PrivateKey returns the secret scalar derived from the RFC 8032 Ed448 private key seed, reduced modulo
+// l, so that ScalarBaseMult of the result is the element returned by ElementFromEd448PublicKey for the matching public
+// key.
+func ScalarFromEd448PrivateKey(seed []byte) (*Scalar, error) {
+	if len(seed) != Ed448SeedLength {
+		return nil, ErrInvalidLength
+	}
+
+	h := sha3.SumSHAKE256(seed, ed448HashLength)
+	defer clear(h)
+
+	s := h[:Ed448SeedLength]
+	s[0] &= 0xfc
+	s[Ed448SeedLength-1] = 0
+	s[Ed448SeedLength-2] |= 0x80
+
+	return NewScalar().reduce(new(big.Int).SetBytes(reverseCopy(s))), nil
+}
@@ -0,0 +1,107 @@
+// SPDX-License-Group: MIT
+//
+// Copyright (C) 2022 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package decaf448_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/bytemare/decaf448"
+)
+
+// ed448Vectors are key pairs from RFC 8032, Section 7.4.
+var ed448Vectors = []struct {
+	seed, public string
+}{
+	{
+		"6c82a562cb808d10d632be89c8513ebf6c929f34ddfa8c9f63c9960ef6e348a3528c8a3fcc2f044e39a3fc5b94492f8f032e7549a20098f95b",
+		"5fd7449b59b461fd2ce787ec616ad46a1da1342485a70e1f8a0ea75d80e96778edf124769b46c7061bd6783df1e50f6cd1fa1abeafe8256180",
+	},
+	{
+		"c4eab05d357007c632f3dbb48489924d552b08fe0c353a0d4a1f00acda2c463afbea67c5e8d2877c5e3bc397a659949ef8021e954e0a12274e",
+		"43ba28f430cdff456ae531545f7ecd0ac834a55d9358c0372bfa0c6c6798c0866aea01eb00742802b8438ea4cb82169c235160627b4c3a9480",
+	},
+}
+
+func TestEd448Keys(t *testing.T) {
+	for i, v := range ed448Vectors {
+		seed, _ := hex.DecodeString(v.seed)
+		public, _ := hex.DecodeString(v.public)
+
+		s, err := decaf448.ScalarFromEd448PrivateKey(seed)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		e, err := decaf448.ElementFromEd448PublicKey(public)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if decaf448.NewGroupElement().ScalarBaseMult(s).Equal(e) != 1 {
+			t.Fatalf("vector %d: the converted keys do not match", i)
+		}
+	}
+}
+
+func TestEd448KeysInvalid(t *testing.T) {
+	public, _ := hex.DecodeString(ed448Vectors[0].public)
+
+	highBits := bytes.Clone(public)
+	highBits[56] |= 1
+
+	// y = p
+	nonCanonical := append(decaf448.FieldOrder(), 0)
+
+	// y = 1 is the identity, and y = -1 is the point of order 2.
+	identity := make([]byte, 57)
+	identity[0] = 1
+
+	order2 := append(decaf448.FieldOrder(), 0)
+	order2[0]--
+
+	// x = 0 has no negative encoding.
+	negativeZero := bytes.Clone(identity)
+	negativeZero[56] = 0x80
+
+	for _, test := range []struct {
+		input    []byte
+		expected error
+	}{
+		{public[:56], decaf448.ErrInvalidLength},
+		{highBits, decaf448.ErrNonCanonical},
+		{nonCanonical, decaf448.ErrNonCanonical},
+		{negativeZero, decaf448.ErrNonCanonical},
+		{identity, decaf448.ErrIdentity},
+		{order2, decaf448.ErrIdentity},
+	} {
+		if _, err := decaf448.ElementFromEd448PublicKey(test.input); !errors.Is(err, test.expected) {
+			t.Fatalf("expected %v, got %v", test.expected, err)
+		}
+	}
+
+	// About half of the y coordinates are not on the curve.
+	notOnCurve := make([]byte, 57)
+	for y := byte(2); ; y++ {
+		notOnCurve[0] = y
+		if _, err := decaf448.ElementFromEd448PublicKey(notOnCurve); err != nil {
+			if !errors.Is(err, decaf448.ErrNotOnGroup) {
+				t.Fatalf("unexpected error %v", err)
+			}
+
+			break
+		}
+	}
+
+	if _, err := decaf448.ScalarFromEd448PrivateKey(make([]byte, 56)); !errors.Is(err, decaf448.ErrInvalidLength) {
+		t.Fatalf("unexpected error %v", err)
+	}
+}
@@ -0,0 +1,73 @@
+// SPDX-License-Group: MIT
+//
+// Copyright (C) 2022 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package edwards448
+
+import "testing"
+
+func BenchmarkScalarMult(b *testing.B) {
+	q := randomPoint(b)
+	s := NewElement().Random(groupOrder)
+
+	var p Point
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		p.ScalarMult(s, q)
+	}
+}
+
+func BenchmarkScalarMultParallel(b *testing.B) {
+	q := randomPoint(b)
+	s := NewElement().Random(groupOrder)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	b.RunParallel(func(pb *testing.PB) {
+		var p Point
+		for pb.Next() {
+			p.ScalarMult(s, q)
+		}
+	})
+}
+
+func BenchmarkPointAdd(b *testing.B) {
+	p, q := randomPoint(b), randomPoint(b)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		p.Add(q)
+	}
+}
+
+func BenchmarkPointDouble(b *testing.B) {
+	p := randomPoint(b)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		p.Double()
+	}
+}
+
+func BenchmarkPointDoubleN(b *testing.B) {
+	p := randomPoint(b)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		p.DoubleN(4)
+	}
+}
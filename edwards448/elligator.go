@@ -0,0 +1,88 @@
+// SPDX-License-Group: MIT
+//
+// Copyright (C) 2022 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package edwards448
+
+// oneMinusTwoD = 1 - 2 * D
+var oneMinusTwoD = NewElement().SetUint64(78163)
+
+// Elligator sets p to the image of t by the Elligator map of decaf448, the MAP function of RFC 9496 applied to a field
+// element. The result is a point of edwards448 in extended coordinates, not reduced to a decaf448 element, and is
+// not necessarily in the prime order subgroup. Mapping a single field element is not a random oracle, which needs
+// the sum of two independent maps as in the decaf448 element derivation.
+func (p *Point) Elligator(t *Element) *Point {
+	/*
+		The MAP function is defined on a 56-byte string, reduced modulo p to obtain a field element t, and
+		processes t as follows:
+
+		   r = -t^2
+		   u0 = d * (r-1)
+		   u1 = (u0 + 1) * (u0 - r)
+
+		   (was_square, v) = SQRT_RATIO_M1(ONE_MINUS_TWO_D, (r + 1) * u1)
+		   v_prime = CT_SELECT(v IF was_square ELSE t * v)
+		   sgn     = CT_SELECT(1 IF was_square ELSE -1)
+		   s = v_prime * (r + 1)
+
+		   w0 = 2 * CT_ABS(s)
+		   w1 = s^2 + 1
+		   w2 = s^2 - 1
+		   w3 = v_prime * s * (r - 1) * ONE_MINUS_TWO_D + sgn
+
+		The result is the point with extended coordinates (w0*w3, w2*w1, w1*w3, w0*w2).
+	*/
+
+	var r, u0, u01, u0r, u1, rMinOne, rPlusOne Element
+
+	// r = -t^2
+	//	   u0 = d * (r-1)
+	//	   u1 = (u0 + 1) * (u0 - r)
+	r.Square(t)
+	r.Negate(&r)
+	rMinOne.Subtract(&r, one)
+	u0.Multiply(D, &rMinOne)
+	u01.Add(&u0, one)
+	u0r.Subtract(&u0, &r)
+	u1.Multiply(&u01, &u0r)
+
+	// (was_square, v) = SQRT_RATIO_M1(ONE_MINUS_TWO_D, (r + 1) * u1)
+	//	   v_prime = CT_SELECT(v IF was_square ELSE t * v)
+	//	   sgn     = CT_SELECT(1 IF was_square ELSE -1)
+	//	   s = v_prime * (r + 1)
+	var vPrime, sgn, s Element
+	rPlusOne.Add(&r, one)
+	u1.Multiply(&u1, &rPlusOne)
+	var v, tv Element
+	wasSquare, _ := v.SqrtRatio(oneMinusTwoD, &u1)
+	vPrime.SelectCT(&v, tv.Multiply(t, &v), wasSquare)
+	sgn.SelectCT(one, minusOne, wasSquare)
+	s.Multiply(&vPrime, &rPlusOne)
+
+	// w0 = 2 * CT_ABS(s)
+	//	   w1 = s^2 + 1
+	//	   w2 = s^2 - 1
+	//	   w3 = v_prime * s * (r - 1) * ONE_MINUS_TWO_D + sgn
+	var w0, w1, w2, w3 Element
+	w0.AbsoluteCT(&s)
+	w0.Multiply(two, &w0)
+	w1.Square(&s)
+	w1.Add(&w1, one)
+	w2.Square(&s)
+	w2.Subtract(&w2, one)
+	w3.Multiply(&vPrime, &s)
+	w3.Multiply(&w3, &rMinOne)
+	w3.Multiply(&w3, oneMinusTwoD)
+	w3.Add(&w3, &sgn)
+
+	p.X.Multiply(&w0, &w3)
+	p.Y.Multiply(&w2, &w1)
+	p.T.Multiply(&w0, &w2)
+	p.Z.Multiply(&w1, &w3)
+
+	return p
+}
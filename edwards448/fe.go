@@ -0,0 +1,308 @@
+// SPDX-License-Group: MIT
+//
+// Copyright (C) 2022 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package edwards448
+
+import (
+	"crypto/rand"
+	"errors"
+	"math/big"
+
+	"github.com/bytemare/decaf448/internal/ct"
+)
+
+// ElementLength is the length of a little-endian encoded field element.
+const ElementLength = 56
+
+var (
+	errElementLength = errors.New("invalid field element length")
+	errNonCanonical  = errors.New("non-canonical field element")
+)
+
+const (
+	// untwisted edwards curve equation: y2 + x2 ≡ 1 - 39081 x2 y2
+
+	// p = 2^448 - 2^224 - 1
+	fieldOrder = "726838724295606890549323807888004534353641360687318060281490199180612328166730772686396383698676545930088884461843637361053498018365439"
+)
+
+var (
+	curveOrder, _ = NewElement().SetString(fieldOrder, 10)
+
+	// D = -39081
+	D, _ = NewElement().SetString("726838724295606890549323807888004534353641360687318060281490199180612328166730772686396383698676545930088884461843637361053498018326358", 10)
+
+	zero     = NewElement().SetUint64(0)
+	one      = NewElement().SetUint64(1)
+	minusOne = NewElement().Subtract(zero, one)
+	two      = NewElement().SetUint64(2)
+	four     = NewElement().SetUint64(4)
+	// (p-1)/2 = 2^447-2^223-1
+	pMinus1Div2, _ = NewElement().SetString("7fffffffffffffffffffffffffffffffffffffffffffffffffffffff7fffffffffffffffffffffffffffffffffffffffffffffffffffffff", 16)
+	// (p-3)/4 = 2^446-2^222-1
+	pMinus3Div4, _ = NewElement().SetString("3fffffffffffffffffffffffffffffffffffffffffffffffffffffffbfffffffffffffffffffffffffffffffffffffffffffffffffffffff", 16)
+)
+
+// FieldOrder returns a new copy of the field order p = 2^448 - 2^224 - 1.
+func FieldOrder() *big.Int {
+	return new(big.Int).Set(&curveOrder.int)
+}
+
+func (e *Element) expPMinus3mod4() *Element {
+	return e.Exp(e, pMinus3Div4)
+}
+
+func reverse(b []byte) []byte {
+	for i := len(b)/2 - 1; i >= 0; i-- {
+		opp := len(b) - 1 - i
+		b[i], b[opp] = b[opp], b[i]
+	}
+
+	return b
+}
+
+type Element struct {
+	int big.Int
+}
+
+// NewElement returns a new field element set to 0.
+func NewElement() *Element {
+	var e Element
+	return &e
+}
+
+func (e *Element) reduce(x, mod *big.Int) *Element {
+	e.int.Mod(x, mod)
+	return e
+}
+
+// Zero sets e to 0. It does not share memory with other elements.
+func (e *Element) Zero() *Element {
+	return e.SetUint64(0)
+}
+
+// One sets e to 1. It does not share memory with other elements.
+func (e *Element) One() *Element {
+	return e.SetUint64(1)
+}
+
+// Wipe sets e to 0, and first overwrites the whole backing array of its value, so that e no longer holds a copy of it.
+// math/big may still leave copies in its internal temporaries.
+func (e *Element) Wipe() *Element {
+	b := e.int.Bits()
+	clear(b[:cap(b)])
+
+	return e.SetUint64(0)
+}
+
+func (e *Element) Set(u *Element) *Element {
+	return e.SetInt(&u.int)
+}
+
+func (e *Element) SetInt(u *big.Int) *Element {
+	e.int.Set(u)
+	return e
+}
+
+// SetUint64 sets e to u, which must be lower than the field order.
+func (e *Element) SetUint64(u uint64) *Element {
+	e.int.SetUint64(u)
+	return e
+}
+
+func (e *Element) SetString(u string, base int) (*Element, error) {
+	if _, ok := e.int.SetString(u, base); !ok {
+		panic(nil)
+	}
+
+	return e, nil
+}
+
+// SetBytesBig sets e to the big-endian integer u, reduced modulo p.
+func (e *Element) SetBytesBig(u []byte) (*Element, error) {
+	e.int.SetBytes(u)
+	return e.reduce(&e.int, &curveOrder.int), nil
+}
+
+// SetBytesLittle sets e to the little-endian integer u, reduced modulo p.
+func (e *Element) SetBytesLittle(u []byte) (*Element, error) {
+	v := make([]byte, len(u))
+	copy(v, u)
+	e.int.SetBytes(reverse(v))
+
+	return e.reduce(&e.int, &curveOrder.int), nil
+}
+
+// SetCanonicalBytes sets e to the 56-byte little-endian integer u, and returns an error if u is not lower than p.
+func (e *Element) SetCanonicalBytes(u []byte) (*Element, error) {
+	if len(u) != ElementLength {
+		return nil, errElementLength
+	}
+
+	var v, order [ElementLength]byte
+	copy(v[:], u)
+	reverse(v[:])
+	curveOrder.int.FillBytes(order[:])
+
+	if ct.LessThan(v[:], order[:]) == 0 {
+		return nil, errNonCanonical
+	}
+
+	e.int.SetBytes(v[:])
+
+	return e, nil
+}
+
+func (e *Element) Random(order *Element) *Element {
+	r, _ := rand.Int(rand.Reader, &order.int)
+	e.int.Set(r)
+
+	return e
+}
+
+func (e *Element) Bytes() []byte {
+	return e.int.Bytes()
+}
+
+// BytesLittle returns the 56-byte little-endian encoding of e.
+func (e *Element) BytesLittle() []byte {
+	out := make([]byte, ElementLength)
+	e.int.FillBytes(out)
+
+	return reverse(out)
+}
+
+func (e *Element) Add(u, v *Element) *Element {
+	return e.reduce(e.int.Add(&u.int, &v.int), &curveOrder.int)
+}
+
+func (e *Element) Subtract(u, v *Element) *Element {
+	return e.reduce(e.int.Sub(&u.int, &v.int), &curveOrder.int)
+}
+
+func (e *Element) Multiply(u, v *Element) *Element {
+	countFieldMul()
+	return e.reduce(e.int.Mul(&u.int, &v.int), &curveOrder.int)
+}
+
+func (e *Element) Square(u *Element) *Element {
+	countFieldSqr()
+	return e.reduce(e.int.Mul(&u.int, &u.int), &curveOrder.int)
+}
+
+func (e *Element) Negate(u *Element) *Element {
+	return e.reduce(e.int.Neg(&u.int), &curveOrder.int)
+}
+
+func (e *Element) Invert(u, exp *Element) *Element {
+	countFieldExp()
+	e.int.Exp(&u.int, &exp.int, &curveOrder.int)
+	return e
+}
+
+func (e *Element) Exp(u, v *Element) *Element {
+	countFieldExp()
+	e.int.Exp(&u.int, &v.int, &curveOrder.int)
+	return e
+}
+
+func (e *Element) Compare(u *Element) int {
+	return e.int.Cmp(&u.int)
+}
+
+func (e *Element) IsZero() int {
+	switch e.int.Sign() {
+	case 0:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// IsNegative returns 1 if e is negative as per IS_NEGATIVE(e), i.e. its least significant bit is set, and 0 otherwise.
+func (e *Element) IsNegative() int {
+	return int(e.int.Bit(0))
+}
+
+func (e *Element) IsEqualCT(u *Element) int {
+	var su, sv [ElementLength]byte
+	e.int.FillBytes(su[:])
+	u.int.FillBytes(sv[:])
+	return ct.Equal(su[:], sv[:])
+}
+
+// SelectCT sets e to u if cond == 1, and to v if cond == 0, without branching on cond. cond must be 0 or 1.
+func (e *Element) SelectCT(u, v *Element, cond int) *Element {
+	var su, sv [ElementLength]byte
+	u.int.FillBytes(su[:])
+	v.int.FillBytes(sv[:])
+	ct.Cmov(sv[:], su[:], cond)
+	e.int.SetBytes(sv[:])
+
+	return e
+}
+
+// SwapCT sets e to u if condition is true, and leaves it unchanged otherwise.
+func (e *Element) SwapCT(u *Element, condition bool) {
+	e.SelectCT(u, e, boolToInt(condition))
+}
+
+// boolToInt returns 1 if b is true, and 0 otherwise.
+func boolToInt(b bool) int {
+	var i int
+	if b {
+		i = 1
+	}
+
+	return i
+}
+
+// IsSquareCT returns 1 if e is a square in the field, and 0 otherwise. 0 is a square. Apart from the big.Int
+// arithmetic, it does not branch on e.
+func (e *Element) IsSquareCT() int {
+	// Euler's criterion: e^((p-1)/2) is 1 for non-zero squares, -1 for non-squares, and 0 for 0.
+	var legendre Element
+	legendre.Exp(e, pMinus1Div2)
+
+	return legendre.IsEqualCT(one) | e.IsEqualCT(zero)
+}
+
+func (e *Element) AbsoluteCT(u *Element) *Element {
+	minU := NewElement().Negate(u)
+	e.SelectCT(minU, u, u.IsNegative())
+
+	return e
+}
+
+func (e *Element) SqrtRatio(u, v *Element) (wasSquare int, fe *Element) {
+	/*
+		SQRT_RATIO_M1(u, v) is defined as follows:
+
+		   r = u * (u * v)^((p - 3) / 4) // Note: (p - 3) / 4 is an integer.
+
+		   check = v * r^2
+		   was_square = CT_EQ(check, u)
+
+		   // Choose the non-negative square root.
+		   r = CT_ABS(r)
+
+		   return (was_square, r)
+	*/
+	var r, check Element
+	r.Multiply(u, v)
+	r.expPMinus3mod4()
+	r.Multiply(&r, u)
+
+	check.Square(&r)
+	check.Multiply(v, &check)
+	wasSquare = check.IsEqualCT(u)
+
+	e.AbsoluteCT(&r)
+
+	return wasSquare, e
+}
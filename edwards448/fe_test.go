@@ -0,0 +1,57 @@
+// SPDX-License-Group: MIT
+//
+// Copyright (C) 2022 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package edwards448
+
+import "testing"
+
+func TestIsSquareCT(t *testing.T) {
+	if zero.IsSquareCT() != 1 || one.IsSquareCT() != 1 {
+		t.Fatal("0 and 1 are squares")
+	}
+
+	// -1 and d are not squares for p = 3 mod 4.
+	if minusOne.IsSquareCT() != 0 || D.IsSquareCT() != 0 {
+		t.Fatal("-1 and d are not squares")
+	}
+
+	for i := 0; i < 10; i++ {
+		r := NewElement().Random(curveOrder)
+		if r.IsZero() == 1 {
+			continue
+		}
+
+		square := NewElement().Square(r)
+		if square.IsSquareCT() != 1 {
+			t.Fatal("expected a square")
+		}
+
+		if NewElement().Negate(square).IsSquareCT() != 0 {
+			t.Fatal("expected a non-square")
+		}
+	}
+}
+
+func TestWipe(t *testing.T) {
+	e := NewElement().Random(curveOrder)
+	words := e.int.Bits()
+	words = words[:cap(words)]
+
+	// Shrinking the value leaves the higher words of the previous value in the backing array.
+	e.SetUint64(1)
+
+	if e.Wipe().IsZero() != 1 {
+		t.Fatal("wiped element is not 0")
+	}
+
+	for _, w := range words {
+		if w != 0 {
+			t.Fatal("backing array was not overwritten")
+		}
+	}
+}
@@ -0,0 +1,16 @@
+// SPDX-License-Group: MIT
+//
+// Copyright (C) 2022 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+//go:build !decaf448_unified
+
+package edwards448
+
+// unifiedDoubling selects the doubling formulas used by Double and DoubleN. By default they use the dedicated doubling
+// formulas, which are complete on this curve. Building with the decaf448_unified tag selects the unified addition
+// formulas instead.
+const unifiedDoubling = false
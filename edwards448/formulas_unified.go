@@ -0,0 +1,15 @@
+// SPDX-License-Group: MIT
+//
+// Copyright (C) 2022 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+//go:build decaf448_unified
+
+package edwards448
+
+// unifiedDoubling selects the unified addition formulas for Double and DoubleN, as requested by the decaf448_unified
+// build tag.
+const unifiedDoubling = true
@@ -0,0 +1,24 @@
+// SPDX-License-Group: MIT
+//
+// Copyright (C) 2022 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package edwards448
+
+// OpCounts holds the number of operations done since the last call to ResetOpCounts. Operations are only counted if
+// the package is built with the decaf448_opcount build tag, e.g. with go test -tags decaf448_opcount, and the
+// counters always read zero otherwise.
+//
+// Additions and doublings are those requested through Add, Double and DoubleN. The ones done internally by
+// ScalarMult are not counted separately, and show up in the field operation counts instead.
+type OpCounts struct {
+	FieldMultiplications  uint64
+	FieldSquarings        uint64
+	FieldExponentiations  uint64
+	PointAdditions        uint64
+	PointDoublings        uint64
+	ScalarMultiplications uint64
+}
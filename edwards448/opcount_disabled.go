@@ -0,0 +1,29 @@
+// SPDX-License-Group: MIT
+//
+// Copyright (C) 2022 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+//go:build !decaf448_opcount
+
+package edwards448
+
+// OpCountEnabled reports whether operation counting is compiled in.
+const OpCountEnabled = false
+
+func countFieldMul()        {}
+func countFieldSqr()        {}
+func countFieldExp()        {}
+func countPointAdd()        {}
+func countPointDoubles(int) {}
+func countScalarMult()      {}
+
+// ReadOpCounts returns zero counts, since operation counting is not compiled in.
+func ReadOpCounts() OpCounts {
+	return OpCounts{}
+}
+
+// ResetOpCounts does nothing, since operation counting is not compiled in.
+func ResetOpCounts() {}
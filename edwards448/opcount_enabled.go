@@ -0,0 +1,49 @@
+// SPDX-License-Group: MIT
+//
+// Copyright (C) 2022 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+//go:build decaf448_opcount
+
+package edwards448
+
+import "sync/atomic"
+
+// OpCountEnabled reports whether operation counting is compiled in.
+const OpCountEnabled = true
+
+var opCounters struct {
+	fieldMul, fieldSqr, fieldExp, pointAdd, pointDbl, scalarMult atomic.Uint64
+}
+
+func countFieldMul()          { opCounters.fieldMul.Add(1) }
+func countFieldSqr()          { opCounters.fieldSqr.Add(1) }
+func countFieldExp()          { opCounters.fieldExp.Add(1) }
+func countPointAdd()          { opCounters.pointAdd.Add(1) }
+func countPointDoubles(n int) { opCounters.pointDbl.Add(uint64(n)) }
+func countScalarMult()        { opCounters.scalarMult.Add(1) }
+
+// ReadOpCounts returns the operation counts since the last reset. Counters are global to the process.
+func ReadOpCounts() OpCounts {
+	return OpCounts{
+		FieldMultiplications:  opCounters.fieldMul.Load(),
+		FieldSquarings:        opCounters.fieldSqr.Load(),
+		FieldExponentiations:  opCounters.fieldExp.Load(),
+		PointAdditions:        opCounters.pointAdd.Load(),
+		PointDoublings:        opCounters.pointDbl.Load(),
+		ScalarMultiplications: opCounters.scalarMult.Load(),
+	}
+}
+
+// ResetOpCounts sets all operation counters to zero.
+func ResetOpCounts() {
+	for _, c := range []*atomic.Uint64{
+		&opCounters.fieldMul, &opCounters.fieldSqr, &opCounters.fieldExp,
+		&opCounters.pointAdd, &opCounters.pointDbl, &opCounters.scalarMult,
+	} {
+		c.Store(0)
+	}
+}
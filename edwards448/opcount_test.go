@@ -0,0 +1,46 @@
+// SPDX-License-Group: MIT
+//
+// Copyright (C) 2022 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package edwards448
+
+import "testing"
+
+func TestOpCounts(t *testing.T) {
+	p, q := randomPoint(t), randomPoint(t)
+	s := NewElement().Random(groupOrder)
+
+	ResetOpCounts()
+
+	p.Add(q).Double().DoubleN(3)
+	p.ScalarMult(s, q)
+	NewElement().Square(s)
+
+	counts := ReadOpCounts()
+
+	if !OpCountEnabled {
+		if counts != (OpCounts{}) {
+			t.Fatal("counts must be zero when counting is disabled")
+		}
+
+		return
+	}
+
+	if counts.PointAdditions != 1 || counts.PointDoublings != 4 || counts.ScalarMultiplications != 1 {
+		t.Fatalf("unexpected counts %+v", counts)
+	}
+
+	if counts.FieldMultiplications == 0 || counts.FieldSquarings == 0 {
+		t.Fatalf("field operations not counted: %+v", counts)
+	}
+
+	ResetOpCounts()
+
+	if ReadOpCounts() != (OpCounts{}) {
+		t.Fatal("counts not reset")
+	}
+}
@@ -0,0 +1,404 @@
+// SPDX-License-Group: MIT
+//
+// Copyright (C) 2022 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+// Package edwards448 exposes the field and extended twisted Edwards point arithmetic underlying decaf448.
+//
+// This package is experimental and low-level: points are not validated, the cofactor is not handled, and the API may
+// change without notice. It is meant for research and optimization work. Protocols should use the decaf448 package,
+// which provides a safe prime-order group abstraction on top of it.
+package edwards448
+
+import (
+	"math/big"
+	"sync"
+
+	"github.com/bytemare/decaf448/internal/ct"
+)
+
+type projP2 struct {
+	x, y, z Element
+}
+
+func (p *projP2) fromExtended(q *Point) *projP2 {
+	p.x.Set(&q.X)
+	p.y.Set(&q.Y)
+	p.z.Set(&q.Z)
+
+	return p
+}
+
+// double sets p = 2 * p, using dbl-2008-hwcd without computing T.
+func (p *projP2) double() *projP2 {
+	var a, b, c, e, f, g, h Element
+	a.Square(&p.x)
+	b.Square(&p.y)
+	c.Square(&p.z)
+	c.Multiply(two, &c)
+	e.Add(&p.x, &p.y)
+	e.Square(&e)
+	e.Subtract(&e, &a)
+	e.Subtract(&e, &b)
+	g.Add(&a, &b)
+	f.Subtract(&g, &c)
+	h.Subtract(&a, &b)
+
+	p.x.Multiply(&e, &f)
+	p.y.Multiply(&g, &h)
+	p.z.Multiply(&f, &g)
+
+	return p
+}
+
+type Point struct {
+	/*
+		Extended Twisted Edwards Coordinates System
+
+		"Twisted Edwards Curves Revisited" - 2008
+		https://link.springer.com/content/pdf/10.1007/978-3-540-89255-7_20.pdf
+	*/
+	X, Y, T, Z Element
+}
+
+func (p *Point) fromP2(q *projP2) *Point {
+	p.X.Multiply(&q.x, &q.z)
+	p.Y.Multiply(&q.y, &q.z)
+	p.T.Multiply(&q.x, &q.y)
+	p.Z.Square(&q.z)
+
+	return p
+}
+
+func pZero() *Point {
+	var p Point
+	return p.Identity()
+}
+
+// Identity sets p to the identity point (0, 1).
+func (p *Point) Identity() *Point {
+	p.X.SetUint64(0)
+	p.Y.SetUint64(1)
+	p.T.SetUint64(0)
+	p.Z.SetUint64(1)
+
+	return p
+}
+
+func (p *Point) Set(q *Point) *Point {
+	p.X.Set(&q.X)
+	p.Y.Set(&q.Y)
+	p.T.Set(&q.T)
+	p.Z.Set(&q.Z)
+
+	return p
+}
+
+func (p *Point) Negate(q *Point) *Point {
+	p.X.Negate(&q.X)
+	p.Y.Set(&q.Y)
+	p.T.Negate(&q.T)
+	p.Z.Set(&q.Z)
+
+	return p
+}
+
+func (p *Point) Subtract(q *Point) *Point {
+	var minusq Point
+	return p.Add(minusq.Negate(q))
+}
+
+func (p *Point) IsEqual(q *Point) int {
+	var f0, f1 Element
+
+	f0.Multiply(&p.X, &q.Y)
+	f1.Multiply(&p.Y, &q.X)
+	res := f0.IsEqualCT(&f1)
+
+	f0.Multiply(&p.Y, &q.Y)
+	f1.Multiply(&p.X, &q.X)
+	res = res | f0.IsEqualCT(&f1)
+
+	return res
+}
+
+func (p *Point) IsInfinity() int {
+	return p.IsEqual(pZero())
+}
+
+func (p *Point) Copy() *Point {
+	var q Point
+	q.X.Set(&p.X)
+	q.Y.Set(&p.Y)
+	q.T.Set(&p.T)
+	q.Z.Set(&p.Z)
+
+	return &q
+}
+
+// q = l = 2^446 - 13818066809895115352007386748515426880336692474882178609894547503885
+// = 181709681073901722637330951972001133588410340171829515070372549795146003961539585716195755291692375963310293709091662304773755859649779
+// h = 4
+const orderPrime = "181709681073901722637330951972001133588410340171829515070372549795146003961539585716195755291692375963310293709091662304773755859649779"
+
+var groupOrder, _ = NewElement().SetString(orderPrime, 10)
+
+// GroupOrder returns a new copy of the prime order l of the group.
+func GroupOrder() *big.Int {
+	return new(big.Int).Set(&groupOrder.int)
+}
+
+// scalarWindows is the number of signed 4-bit digits of a scalar below the group order.
+const scalarWindows = 112
+
+// ScalarMult sets p = s * q, and panics if s is not lower than the group order. It uses a fixed window of signed 4-bit
+// digits and constant-time table lookups, and its sequence of operations does not depend on the value of s.
+func (p *Point) ScalarMult(s *Element, q *Point) *Point {
+	if groupOrder.int.Cmp(&s.int) <= 0 {
+		panic("scalar out of order")
+	}
+
+	countScalarMult()
+
+	tmp := scalarMultPool.Get().(*scalarMultTemporaries)
+	defer func() {
+		tmp.wipe()
+		scalarMultPool.Put(tmp)
+	}()
+
+	table, r, t := &tmp.table, &tmp.r, &tmp.t
+	table.init(q)
+
+	digits := signedRadix16(s)
+
+	table.selectInto(r, digits[scalarWindows-1])
+
+	for i := scalarWindows - 2; i >= 0; i-- {
+		r.doubleN(4)
+		table.selectInto(t, digits[i])
+		r.addUnified(t)
+	}
+
+	return p.Set(r)
+}
+
+// scalarMultTemporaries holds the points used by ScalarMult. They are pooled, so that the big.Int backing arrays of
+// their coordinates are reused across calls instead of being reallocated. Every field is overwritten before being read,
+// and wiped before being put back, since r ends up holding the result, e.g. an ECDH shared point.
+type scalarMultTemporaries struct {
+	table lookupTable
+	r, t  Point
+}
+
+func (t *scalarMultTemporaries) wipe() {
+	for i := range t.table {
+		t.table[i].wipe()
+	}
+
+	t.r.wipe()
+	t.t.wipe()
+}
+
+// wipe overwrites the coordinates of p, and leaves it in an invalid state.
+func (p *Point) wipe() {
+	p.X.Wipe()
+	p.Y.Wipe()
+	p.Z.Wipe()
+	p.T.Wipe()
+}
+
+var scalarMultPool = sync.Pool{
+	New: func() any {
+		return new(scalarMultTemporaries)
+	},
+}
+
+// signedRadix16 returns the digits d_i in [-8, 8) of s = sum(d_i * 16^i), with the last digit in [0, 8) since s is
+// lower than the group order 2^446 - c. The recoding does not branch on the digits.
+func signedRadix16(s *Element) [scalarWindows]int8 {
+	var b [56]byte
+	s.int.FillBytes(b[:])
+	reverse(b[:])
+
+	var digits [scalarWindows]int8
+	for i := 0; i < len(b); i++ {
+		digits[2*i] = int8(b[i] & 15)
+		digits[2*i+1] = int8(b[i] >> 4)
+	}
+
+	for i := 0; i < scalarWindows-1; i++ {
+		carry := (digits[i] + 8) >> 4
+		digits[i] -= carry << 4
+		digits[i+1] += carry
+	}
+
+	return digits
+}
+
+// lookupTable holds the multiples [1*q, 2*q, ..., 8*q] of a point q.
+type lookupTable [8]Point
+
+func (t *lookupTable) init(q *Point) {
+	t[0].Set(q)
+	for i := 1; i < len(t); i++ {
+		t[i].Set(&t[i-1])
+		t[i].addUnified(q)
+	}
+}
+
+// selectInto sets p = d * q for d in [-8, 8], reading every table entry and without branching on d.
+func (t *lookupTable) selectInto(p *Point, d int8) {
+	// Compute the absolute value of d and its sign without branching: mask is -1 if d is negative, and 0 otherwise.
+	mask := d >> 7
+	abs := uint8((d ^ mask) - mask)
+	neg := int(mask & 1)
+
+	p.Set(pZero())
+
+	for i := range t {
+		p.selectCT(&t[i], ct.ByteEq(abs, uint8(i+1)))
+	}
+
+	var minus Point
+	minus.Negate(p)
+	p.selectCT(&minus, neg)
+}
+
+// selectCT sets p = q if cond == 1, and leaves p unchanged if cond == 0, without branching on cond.
+func (p *Point) selectCT(q *Point, cond int) {
+	p.X.SelectCT(&q.X, &p.X, cond)
+	p.Y.SelectCT(&q.Y, &p.Y, cond)
+	p.T.SelectCT(&q.T, &p.T, cond)
+	p.Z.SelectCT(&q.Z, &p.Z, cond)
+}
+
+func (p *Point) Double() *Point {
+	countPointDoubles(1)
+
+	if unifiedDoubling {
+		return p.doubleUnified()
+	}
+
+	return p.doubleDedicated()
+}
+
+// Add sets p = p + q with the complete unified formulas, whose sequence of operations does not depend on the operands.
+// Operands that are equal or differ by a point of order 2 are common between decaf representatives, and the dedicated
+// addition formulas would need a data-dependent fallback for them, so they are not implemented.
+func (p *Point) Add(q *Point) *Point {
+	countPointAdd()
+
+	return p.addUnified(q)
+}
+
+// DoubleN sets p = 2^n * p. Intermediate doublings are done in projective coordinates, skipping the computation of
+// T, which is only recovered in the last doubling.
+func (p *Point) DoubleN(n int) *Point {
+	if n <= 0 {
+		return p
+	}
+
+	countPointDoubles(n)
+
+	return p.doubleN(n)
+}
+
+func (p *Point) doubleN(n int) *Point {
+	if unifiedDoubling {
+		for i := 0; i < n; i++ {
+			p.doubleUnified()
+		}
+
+		return p
+	}
+
+	var q projP2
+	q.fromExtended(p)
+
+	for i := 1; i < n; i++ {
+		q.double()
+	}
+
+	// The dedicated doubling does not read T, so the last doubling can be done on the projective coordinates.
+	p.X.Set(&q.x)
+	p.Y.Set(&q.y)
+	p.Z.Set(&q.z)
+
+	return p.doubleDedicated()
+}
+
+func (p *Point) doubleDedicated() *Point {
+	/*
+		dbl-2008-hwcd, with a = 1.
+
+		The point P3 = (X3,Y3,T3,Z3) = P1 + P1 is given by
+
+		$ A = X1^2 $
+		$ B = Y1^2 $
+		$ C = 2 \times Z1^2 $
+		$ D = a \times A $
+		$ E = (X1 + Y1)^2 - A -B $
+		$ G = D + B $
+		$ F = G - C $
+		$ H = D - B $
+		$ X3 = E \times F $
+		$ Y3 = G \times H $
+		$ T3 = E \times H $
+		$ Z3 = F \times G $
+	*/
+
+	var a, b, c, d, e, f, g, h Element
+	a.Square(&p.X)
+	b.Square(&p.Y)
+	c.Square(&p.Z)
+	c.Multiply(two, &c)
+	d.Set(&a)
+	e.Add(&p.X, &p.Y)
+	e.Square(&e)
+	e.Subtract(&e, &a)
+	e.Subtract(&e, &b)
+	g.Add(&d, &b)
+	f.Subtract(&g, &c)
+	h.Subtract(&d, &b)
+
+	p.X.Multiply(&e, &f)
+	p.Y.Multiply(&g, &h)
+	p.T.Multiply(&e, &h)
+	p.Z.Multiply(&f, &g)
+
+	return p
+}
+
+func (p *Point) doubleUnified() *Point {
+	var q Point
+	q.Set(p)
+
+	return p.addUnified(&q)
+}
+
+func (p *Point) addUnified(q *Point) *Point {
+	// add-2008-hwcd, with a = 1. These formulas are complete on this curve since d is not a square.
+	var a, b, c, d, e, f, g, h, ee, ff Element
+	a.Multiply(&p.X, &q.X) // A = x1*x2
+	b.Multiply(&p.Y, &q.Y) // B = y1*y2
+	c.Multiply(&q.T, &p.T) // C = d*t1*t2
+	c.Multiply(&c, D)      //
+	d.Multiply(&p.Z, &q.Z) // D = z1*z2
+	ee.Add(&p.X, &p.Y)     // x1+y1
+	ff.Add(&q.X, &q.Y)     // x2+y2
+	e.Multiply(&ee, &ff)   // E = (x1+y1)*(x2+y2)-A-B
+	e.Subtract(&e, &a)     //
+	e.Subtract(&e, &b)     //
+	f.Subtract(&d, &c)     // F = D-C
+	g.Add(&d, &c)          // g = D+C
+	h.Subtract(&b, &a)     // H = B-A
+	p.X.Multiply(&e, &f)   // X = E * F
+	p.Y.Multiply(&g, &h)   // Y = G * H
+	p.T.Multiply(&e, &h)   // T = E * H
+	p.Z.Multiply(&f, &g)   // Z = F * G
+
+	return p
+}
@@ -0,0 +1,266 @@
+// SPDX-License-Group: MIT
+//
+// Copyright (C) 2022 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package edwards448
+
+import (
+	"math/big"
+	"testing"
+)
+
+// basePoint returns the Ed448 base point from RFC 8032.
+func basePoint() *Point {
+	x, _ := NewElement().SetString("224580040295924300187604334099896036246789641632564134246125461686950415467406032909029192869357953282578032075146446173674602635247710", 10)
+	y, _ := NewElement().SetString("298819210078481492676017930443930673437544040154080242095928241372331506189835876003536878655418784733982303233503462500531545062832660", 10)
+
+	return affinePoint(x, y)
+}
+
+func randomPoint(t testing.TB) *Point {
+	return new(Point).ScalarMult(NewElement().Random(groupOrder), basePoint())
+}
+
+func affinePoint(x, y *Element) *Point {
+	var p Point
+	p.X.Set(x)
+	p.Y.Set(y)
+	p.T.Multiply(x, y)
+	p.Z.Set(one)
+
+	return &p
+}
+
+// samePoint returns whether p and q represent the same curve point, as opposed to IsEqual which compares group
+// elements.
+func samePoint(p, q *Point) bool {
+	var a, b Element
+
+	a.Multiply(&p.X, &q.Z)
+	b.Multiply(&q.X, &p.Z)
+
+	if a.Compare(&b) != 0 {
+		return false
+	}
+
+	a.Multiply(&p.Y, &q.Z)
+	b.Multiply(&q.Y, &p.Z)
+
+	return a.Compare(&b) == 0
+}
+
+// isValidPoint checks that p is on the curve x^2 + y^2 = 1 + d*x^2*y^2, has a non-zero Z, and that T = X*Y/Z.
+func isValidPoint(p *Point) bool {
+	if p.Z.IsZero() == 1 {
+		return false
+	}
+
+	var xx, yy, zz, left, right, xy, tz Element
+	xx.Square(&p.X)
+	yy.Square(&p.Y)
+	zz.Square(&p.Z)
+
+	left.Add(&xx, &yy)
+	left.Multiply(&left, &zz)
+	right.Multiply(&xx, &yy)
+	right.Multiply(&right, D)
+	zz.Square(&zz)
+	right.Add(&right, &zz)
+
+	xy.Multiply(&p.X, &p.Y)
+	tz.Multiply(&p.T, &p.Z)
+
+	return left.Compare(&right) == 0 && xy.Compare(&tz) == 0
+}
+
+// formulaTestPoints returns a set of points covering the usual exceptional cases of addition and doubling formulas:
+// the identity, all points of order 2 and 4, and random points together with their negation and their sum with
+// torsion points.
+func formulaTestPoints(t *testing.T) []*Point {
+	torsion := []*Point{
+		pZero(),
+		affinePoint(zero, minusOne),
+		affinePoint(one, zero),
+		affinePoint(minusOne, zero),
+	}
+
+	points := append([]*Point{}, torsion...)
+
+	for i := 0; i < 3; i++ {
+		r := randomPoint(t)
+		points = append(points, r, new(Point).Negate(r))
+
+		for _, tp := range torsion[1:] {
+			points = append(points, r.Copy().addUnified(tp))
+		}
+	}
+
+	return points
+}
+
+func TestPointFormulas(t *testing.T) {
+	points := formulaTestPoints(t)
+
+	for i, p := range points {
+		if !isValidPoint(p) {
+			t.Fatalf("test point %d is invalid", i)
+		}
+
+		dedicated := p.Copy().doubleDedicated()
+		unified := p.Copy().doubleUnified()
+
+		if !isValidPoint(dedicated) || !isValidPoint(unified) || !samePoint(dedicated, unified) {
+			t.Fatalf("doubling formulas differ on point %d", i)
+		}
+
+		for j, q := range points {
+			sum := p.Copy().addUnified(q)
+			if !isValidPoint(sum) || !samePoint(sum, q.Copy().addUnified(p)) {
+				t.Fatalf("addition is invalid or not commutative on points %d and %d", i, j)
+			}
+
+			if !samePoint(sum.addUnified(new(Point).Negate(q)), p) {
+				t.Fatalf("(p + q) - q != p on points %d and %d", i, j)
+			}
+		}
+	}
+}
+
+// sameCoordinates returns whether p and q have the same projective coordinates, which tells formulas apart.
+func sameCoordinates(p, q *Point) bool {
+	return p.X.Compare(&q.X) == 0 && p.Y.Compare(&q.Y) == 0 && p.Z.Compare(&q.Z) == 0 && p.T.Compare(&q.T) == 0
+}
+
+func TestPointFormulasSelection(t *testing.T) {
+	p, q := randomPoint(t), randomPoint(t)
+
+	// The two doubling formulas give different coordinates for the same point, so the check below is meaningful.
+	dedicated, unified := p.Copy().doubleDedicated(), p.Copy().doubleUnified()
+	if sameCoordinates(dedicated, unified) {
+		t.Fatal("doubling formulas cannot be told apart")
+	}
+
+	want := dedicated
+	if unifiedDoubling {
+		want = unified
+	}
+
+	if !sameCoordinates(p.Copy().Double(), want) {
+		t.Fatalf("Double does not use the selected formulas, unifiedDoubling = %v", unifiedDoubling)
+	}
+
+	if !sameCoordinates(p.Copy().Add(q), p.Copy().addUnified(q)) {
+		t.Fatal("Add does not use the unified formulas")
+	}
+}
+
+func TestPointDoubleN(t *testing.T) {
+	for _, p := range formulaTestPoints(t) {
+		q := p.Copy()
+
+		for n := 0; n < 6; n++ {
+			r := p.Copy().DoubleN(n)
+			if !isValidPoint(r) || !samePoint(q, r) {
+				t.Fatalf("DoubleN(%d) differs from repeated doubling", n)
+			}
+
+			q.Double()
+		}
+	}
+}
+
+// referenceScalarMult computes s * q with a variable-time double-and-add.
+func referenceScalarMult(s *Element, q *Point) *Point {
+	r := pZero()
+	for i := s.int.BitLen() - 1; i >= 0; i-- {
+		r.addUnified(r.Copy())
+		if s.int.Bit(i) == 1 {
+			r.addUnified(q)
+		}
+	}
+
+	return r
+}
+
+func TestPointScalarMult(t *testing.T) {
+	q := randomPoint(t)
+	lMinusOne := NewElement().Subtract(groupOrder, one)
+
+	scalars := []*Element{
+		zero, one, two, lMinusOne,
+		NewElement().SetUint64(8),
+		NewElement().SetUint64(16*15 - 8),
+	}
+
+	for i := 0; i < 10; i++ {
+		scalars = append(scalars, NewElement().Random(groupOrder))
+	}
+
+	for _, s := range scalars {
+		if !samePoint(new(Point).ScalarMult(s, q), referenceScalarMult(s, q)) {
+			t.Fatalf("unexpected result for scalar %s", s.int.String())
+		}
+	}
+
+	// (l - 1) * q = -q
+	if !samePoint(new(Point).ScalarMult(lMinusOne, q), new(Point).Negate(q)) {
+		t.Fatal("(l-1) * q != -q")
+	}
+}
+
+func TestSignedRadix16(t *testing.T) {
+	for i := 0; i < 10; i++ {
+		s := NewElement().Random(groupOrder)
+		digits := signedRadix16(s)
+
+		sum, power := new(big.Int), big.NewInt(1)
+		for j, d := range digits {
+			if d < -8 || d > 8 || (j == scalarWindows-1 && d < 0) {
+				t.Fatalf("digit %d out of range: %d", j, d)
+			}
+
+			sum.Add(sum, new(big.Int).Mul(big.NewInt(int64(d)), power))
+			power.Lsh(power, 4)
+		}
+
+		if sum.Cmp(&s.int) != 0 {
+			t.Fatal("recoding does not sum to the scalar")
+		}
+	}
+}
+
+func TestElligator(t *testing.T) {
+	for _, fe := range []*Element{zero, one, minusOne, NewElement().Random(curveOrder), NewElement().Random(curveOrder)} {
+		p := new(Point).Elligator(fe)
+		if !isValidPoint(p) {
+			t.Fatalf("invalid point for %s", fe.int.String())
+		}
+
+		if !samePoint(p, new(Point).Elligator(fe)) {
+			t.Fatal("map is not deterministic")
+		}
+	}
+}
+
+func TestScalarMultTemporariesWipe(t *testing.T) {
+	var tmp scalarMultTemporaries
+	tmp.table.init(randomPoint(t))
+	tmp.r.Set(randomPoint(t))
+	tmp.t.Set(randomPoint(t))
+	tmp.wipe()
+
+	for _, p := range append(tmp.table[:], tmp.r, tmp.t) {
+		for _, c := range []*Element{&p.X, &p.Y, &p.Z, &p.T} {
+			words := c.int.Bits()
+			for _, w := range words[:cap(words)] {
+				if w != 0 {
+					t.Fatal("scalar multiplication temporaries were not wiped")
+				}
+			}
+		}
+	}
+}
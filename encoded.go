@@ -0,0 +1,39 @@
+// SPDX-License-Group: MIT
+//
+// Copyright (C) 2022 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package decaf448
+
+// ElementEncoding is the type of element encodings returned by Encode and accepted by Decode. Plain []byte values
+// convert implicitly, but other named byte slice types, like the encodings of other kinds of values, do not, so that
+// passing one where an element encoding is expected is a compile-time error.
+type ElementEncoding []byte
+
+// EncodedElement holds the canonical encoding of an element. It is comparable, and can be used as a map key: since
+// encodings are canonical, two EncodedElement values are equal if and only if they encode the same element. Note that
+// == is not constant-time, use EqualFixedEncodings for secret values.
+type EncodedElement [encodingLength]byte
+
+// EncodedArray returns the canonical encoding of e as an EncodedElement.
+func (e *DecafElement) EncodedArray() EncodedElement {
+	return *e.cachedEncoding()
+}
+
+// Element decodes b into a new element, and returns an error if b is not a valid encoding.
+func (b EncodedElement) Element() (*DecafElement, error) {
+	e := NewGroupElement()
+	if err := e.decode(b[:]); err != nil {
+		return nil, err
+	}
+
+	return e, nil
+}
+
+// Bytes returns a copy of b as a slice.
+func (b EncodedElement) Bytes() []byte {
+	return append([]byte(nil), b[:]...)
+}
@@ -0,0 +1,22 @@
+// SPDX-License-Group: MIT
+//
+// Copyright (C) 2022 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package decaf448
+
+import "github.com/bytemare/decaf448/internal/ct"
+
+// EqualEncodings returns 1 if a and b are equal, and 0 otherwise. The time taken depends on the lengths of a and b,
+// but not on their contents. Use it rather than bytes.Equal when comparing encodings that may be secret.
+func EqualEncodings(a, b []byte) int {
+	return ct.Equal(a, b)
+}
+
+// EqualFixedEncodings returns 1 if the encodings a and b are equal, and 0 otherwise, in constant time.
+func EqualFixedEncodings(a, b *EncodedElement) int {
+	return ct.Equal(a[:], b[:])
+}
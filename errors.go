@@ -0,0 +1,34 @@
+// SPDX-License-Group: MIT
+//
+// Copyright (C) 2022 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package decaf448
+
+import "errors"
+
+// Errors returned by parsing and validation functions. Returned errors may carry more details, and should be tested
+// with errors.Is. Functions that panic on invalid input, like Decode, panic with one of these errors.
+var (
+	// ErrInvalidLength is returned when an input does not have the expected length.
+	ErrInvalidLength = errors.New("invalid length")
+
+	// ErrInvalidFormat is returned when an input is not well-formed in its format, e.g. invalid hexadecimal or base64, or
+	// a trusted encoding whose integrity tag does not match.
+	ErrInvalidFormat = errors.New("invalid format")
+
+	// ErrNonCanonical is returned when an encoding is not the canonical encoding of a value.
+	ErrNonCanonical = errors.New("non-canonical encoding")
+
+	// ErrNotOnGroup is returned when an input does not represent an element of the group.
+	ErrNotOnGroup = errors.New("not a group element")
+
+	// ErrScalarOutOfRange is returned when a scalar is not in the accepted range.
+	ErrScalarOutOfRange = errors.New("scalar out of range")
+
+	// ErrIdentity is returned when an element is the identity element where it is not allowed.
+	ErrIdentity = errors.New("identity element")
+)
@@ -0,0 +1,108 @@
+// SPDX-License-Group: MIT
+//
+// Copyright (C) 2022 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package decaf448
+
+import (
+	"crypto/sha3"
+	"encoding/binary"
+	"errors"
+	"math"
+)
+
+const (
+	// h2cSuite is the hash-to-group suite identifier for decaf448 from RFC 9380.
+	h2cSuite = "decaf448_XOF:SHAKE256_D448MAP_RO_"
+
+	dstMaxLength    = 255
+	dstLongPrefix   = "H2C-OVERSIZE-DST-"
+	dstHashedLength = 56 // ceil(2 * k / 8), with k = 224
+	expandMaxLength = 65535
+
+	// hashToGroupNSeparator separates the caller's DST from the element index in HashToGroupN.
+	hashToGroupNSeparator = "-N"
+)
+
+var (
+	errEmptyDST     = errors.New("zero-length DST")
+	errHashToGroupN = errors.New("invalid number of elements")
+)
+
+// HashToGroup sets e to the hash of msg to the group using the domain separation tag dst, as specified by the
+// decaf448_XOF:SHAKE256_D448MAP_RO_ suite of RFC 9380. It panics if dst is empty.
+func (e *DecafElement) HashToGroup(msg, dst []byte) *DecafElement {
+	return e.SetUniformBytes(expandXOF(msg, dst, uniformBytesLength))
+}
+
+// HashToGroupN returns n elements derived from msg and dst. The i-th element is
+// HashToGroup(msg, dst || "-N" || I2OSP(i, 4)), so every element has its own expansion with the index bound into the
+// domain separation tag, and a prefix of the output does not depend on n. The separator keeps these tags apart from
+// a caller's DST that happens to end with 4 bytes. It panics if n is negative or does not fit in 4 bytes, or if dst
+// is empty.
+func HashToGroupN(msg, dst []byte, n int) []*DecafElement {
+	if n < 0 || uint64(n) > math.MaxUint32 {
+		panic(errHashToGroupN)
+	}
+
+	if len(dst) == 0 {
+		panic(errEmptyDST)
+	}
+
+	indexed := make([]byte, len(dst)+len(hashToGroupNSeparator)+4)
+	copy(indexed, dst)
+	copy(indexed[len(dst):], hashToGroupNSeparator)
+
+	elements := make([]*DecafElement, n)
+
+	for i := range elements {
+		binary.BigEndian.PutUint32(indexed[len(dst)+len(hashToGroupNSeparator):], uint32(i))
+		elements[i] = NewGroupElement().HashToGroup(msg, indexed)
+	}
+
+	return elements
+}
+
+// expandXOF implements expand_message_xof from RFC 9380 with SHAKE256.
+func expandXOF(msg, dst []byte, length int) []byte {
+	if length > expandMaxLength {
+		panic("requested length is too high")
+	}
+
+	dst = vetDST(dst)
+
+	h := sha3.NewSHAKE256()
+	_, _ = h.Write(msg)
+	_, _ = h.Write([]byte{byte(length >> 8), byte(length)})
+	_, _ = h.Write(dst)
+	_, _ = h.Write([]byte{byte(len(dst))})
+
+	out := make([]byte, length)
+	_, _ = h.Read(out)
+
+	return out
+}
+
+// vetDST returns dst, or its hash if it is longer than 255 bytes.
+func vetDST(dst []byte) []byte {
+	if len(dst) == 0 {
+		panic(errEmptyDST)
+	}
+
+	if len(dst) <= dstMaxLength {
+		return dst
+	}
+
+	h := sha3.NewSHAKE256()
+	_, _ = h.Write([]byte(dstLongPrefix))
+	_, _ = h.Write(dst)
+
+	out := make([]byte, dstHashedLength)
+	_, _ = h.Read(out)
+
+	return out
+}
@@ -0,0 +1,137 @@
+// SPDX-License-Group: MIT
+//
+// Copyright (C) 2022 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package decaf448
+
+import (
+	"bytes"
+	"crypto/sha3"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestExpandXOF(t *testing.T) {
+	msg, dst := []byte("abc"), []byte("QUUX-V01-CS02-with-expander-SHAKE256")
+
+	// msg || I2OSP(len, 2) || DST || I2OSP(len(DST), 1)
+	prime := append(append(append([]byte("abc"), 0, 112), dst...), byte(len(dst)))
+	if !bytes.Equal(expandXOF(msg, dst, 112), sha3.SumSHAKE256(prime, 112)) {
+		t.Fatal("unexpected expand_message_xof output")
+	}
+
+	long := bytes.Repeat([]byte("a"), 256)
+	hashed := sha3.SumSHAKE256(append([]byte("H2C-OVERSIZE-DST-"), long...), 56)
+
+	if !bytes.Equal(expandXOF(msg, long, 112), expandXOF(msg, hashed, 112)) {
+		t.Fatal("oversized DST is not hashed")
+	}
+}
+
+func TestHashToGroupPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected panic on empty DST")
+		}
+	}()
+
+	NewGroupElement().HashToGroup([]byte("msg"), nil)
+}
+
+func TestHashToGroupN(t *testing.T) {
+	msg, dst := []byte("msg"), []byte("dst")
+
+	elements := HashToGroupN(msg, dst, 1024)
+
+	seen := map[EncodedElement]bool{}
+	for i, e := range elements {
+		seen[e.EncodedArray()] = true
+
+		if i >= 4 {
+			continue
+		}
+
+		indexed := append([]byte("dst-N"), 0, 0, 0, byte(i))
+		if !bytes.Equal(e.Encode(), NewGroupElement().HashToGroup(msg, indexed).Encode()) {
+			t.Fatalf("unexpected element %d", i)
+		}
+	}
+
+	// A caller's DST ending in an index must not reproduce the derived elements.
+	if bytes.Equal(NewGroupElement().HashToGroup(msg, append(bytes.Clone(dst), 0, 0, 0, 0)).Encode(), elements[0].Encode()) {
+		t.Fatal("HashToGroup(msg, dst || I2OSP(0, 4)) collides with HashToGroupN(msg, dst)[0]")
+	}
+
+	if len(seen) != 1024 {
+		t.Fatal("derived elements are not distinct")
+	}
+
+	if !bytes.Equal(HashToGroupN(msg, dst, 1)[0].Encode(), elements[0].Encode()) {
+		t.Fatal("a prefix of the output depends on the count")
+	}
+
+	if len(HashToGroupN(msg, dst, 0)) != 0 {
+		t.Fatal("unexpected number of elements")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected panic for a negative count")
+		}
+	}()
+
+	HashToGroupN(msg, dst, -1)
+}
+
+func TestRandomOracle(t *testing.T) {
+	data := []byte("data")
+	o := NewRandomOracle("protocol")
+
+	if !bytes.Equal(o.DeriveElement("label", data).Encode(), NewRandomOracle("protocol").DeriveElement("label", data).Encode()) {
+		t.Fatal("derivation is not deterministic")
+	}
+
+	// Different splits of the same concatenated string must yield different tags and elements.
+	if bytes.Equal(NewRandomOracle("ab").dst("c"), NewRandomOracle("a").dst("bc")) {
+		t.Fatal("DST construction is not injective")
+	}
+
+	for _, other := range []*DecafElement{
+		o.DeriveElement("other", data),
+		NewRandomOracle("other").DeriveElement("label", data),
+		o.DeriveElement("label", []byte("other")),
+	} {
+		if bytes.Equal(o.DeriveElement("label", data).Encode(), other.Encode()) {
+			t.Fatal("derived elements are not domain separated")
+		}
+	}
+}
+
+func TestRandomOracleLabelLength(t *testing.T) {
+	longest := strings.Repeat("a", 65535)
+
+	// Labels of up to 65535 bytes are accepted, and their length prefixes are exact.
+	if dst := NewRandomOracle(longest).dst(longest); !bytes.Equal(dst[:2], []byte{0xff, 0xff}) {
+		t.Fatal("unexpected protocol length prefix")
+	}
+
+	for name, f := range map[string]func(){
+		"protocol": func() { NewRandomOracle(longest + "a") },
+		"label":    func() { NewRandomOracle("protocol").DeriveElement(longest+"a", nil) },
+	} {
+		func() {
+			defer func() {
+				if err, _ := recover().(error); !errors.Is(err, ErrInvalidLength) {
+					t.Fatalf("expected a panic for a %s of 65536 bytes", name)
+				}
+			}()
+
+			f()
+		}()
+	}
+}
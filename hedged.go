@@ -0,0 +1,47 @@
+// SPDX-License-Group: MIT
+//
+// Copyright (C) 2022 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package decaf448
+
+import (
+	"crypto/rand"
+	"crypto/sha3"
+	"encoding/binary"
+	"io"
+)
+
+const (
+	// hedgedLabel domain separates the hedged randomness stream.
+	hedgedLabel = "decaf448_Hedged"
+
+	// hedgedRandomLength is the number of bytes read from crypto/rand per stream.
+	hedgedRandomLength = 64
+)
+
+// hedgedRandom is the system random number generator, and is only replaced in tests.
+var hedgedRandom = rand.Reader
+
+// NewHedgedReader returns a stream of random bytes derived with SHAKE256 from 64 bytes of crypto/rand output, a
+// long-term seed, and auxiliary entropy provided by the caller, such as a message or a counter. If the system random
+// number generator fails silently, the output remains unpredictable to anyone not knowing seed, and distinct for
+// distinct aux. seed and aux may be empty, and are not retained. The stream can be passed to ECDHCurve.GenerateKey,
+// or used for nonces. A new reader should be created for each secret.
+func NewHedgedReader(seed, aux []byte) (io.Reader, error) {
+	var random [hedgedRandomLength]byte
+	if _, err := io.ReadFull(hedgedRandom, random[:]); err != nil {
+		return nil, err
+	}
+
+	h := sha3.NewSHAKE256()
+	for _, input := range [][]byte{[]byte(hedgedLabel), random[:], seed, aux} {
+		_, _ = h.Write(binary.BigEndian.AppendUint64(nil, uint64(len(input))))
+		_, _ = h.Write(input)
+	}
+
+	return h, nil
+}
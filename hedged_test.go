@@ -0,0 +1,56 @@
+// SPDX-License-Group: MIT
+//
+// Copyright (C) 2022 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package decaf448
+
+import (
+	"bytes"
+	"io"
+	"testing"
+)
+
+type zeroReader struct{}
+
+func (zeroReader) Read(p []byte) (int, error) {
+	clear(p)
+	return len(p), nil
+}
+
+func TestHedgedReaderBrokenRandom(t *testing.T) {
+	defer func(r io.Reader) { hedgedRandom = r }(hedgedRandom)
+	hedgedRandom = zeroReader{}
+
+	read := func(seed, aux []byte) []byte {
+		r, err := NewHedgedReader(seed, aux)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		out := make([]byte, 64)
+		_, _ = r.Read(out)
+
+		return out
+	}
+
+	// With a broken generator, the output is still bound to the seed and the auxiliary input, unambiguously.
+	outputs := [][]byte{read([]byte("seed"), []byte("aux")), read([]byte("other"), []byte("aux")),
+		read([]byte("seed"), []byte("other")), read([]byte("seeda"), []byte("ux"))}
+
+	for i := range outputs {
+		for j := i + 1; j < len(outputs); j++ {
+			if bytes.Equal(outputs[i], outputs[j]) {
+				t.Fatalf("outputs %d and %d are equal", i, j)
+			}
+		}
+	}
+
+	hedgedRandom = io.LimitReader(zeroReader{}, 10)
+	if _, err := NewHedgedReader(nil, nil); err == nil {
+		t.Fatal("expected error on random failure")
+	}
+}
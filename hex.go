@@ -0,0 +1,34 @@
+// SPDX-License-Group: MIT
+//
+// Copyright (C) 2022 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package decaf448
+
+import (
+	"encoding/hex"
+	"fmt"
+)
+
+// EncodeHex returns the lowercase hexadecimal form of the 56-byte canonical encoding of e.
+func (e *DecafElement) EncodeHex() string {
+	return hex.EncodeToString(e.cachedEncoding()[:])
+}
+
+// DecodeHex sets e to the decoding of the hexadecimal string s, and returns an error if s is not the hexadecimal form
+// of a valid 56-byte encoding. e is left unchanged if an error is returned.
+func (e *DecafElement) DecodeHex(s string) error {
+	if len(s) != 2*encodingLength {
+		return ErrInvalidLength
+	}
+
+	var buf [encodingLength]byte
+	if _, err := hex.Decode(buf[:], []byte(s)); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidFormat, err)
+	}
+
+	return e.decode(buf[:])
+}
@@ -0,0 +1,63 @@
+// SPDX-License-Group: MIT
+//
+// Copyright (C) 2022 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package decaf448_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/bytemare/decaf448"
+)
+
+func TestHex(t *testing.T) {
+	e := randomElement(t)
+
+	s := e.EncodeHex()
+	if s != hex.EncodeToString(e.Encode()) {
+		t.Fatal("unexpected hex encoding")
+	}
+
+	d := decaf448.NewGroupElement()
+	if err := d.DecodeHex(s); err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(d.Encode(), e.Encode()) {
+		t.Fatal("element differs after hex decoding")
+	}
+
+	if err := d.DecodeHex(strings.ToUpper(s)); err != nil {
+		t.Fatal(err)
+	}
+
+	invalid := e.Encode()
+	invalid[0] |= 1
+
+	for _, test := range []struct {
+		input    string
+		expected error
+	}{
+		{"", decaf448.ErrInvalidLength},
+		{s[:110], decaf448.ErrInvalidLength},
+		{s + "00", decaf448.ErrInvalidLength},
+		{"zz" + s[2:], decaf448.ErrInvalidFormat},
+		{hex.EncodeToString(invalid), decaf448.ErrNonCanonical},
+	} {
+		if err := d.DecodeHex(test.input); !errors.Is(err, test.expected) {
+			t.Fatalf("%q: expected %v, got %v", test.input, test.expected, err)
+		}
+	}
+
+	if !bytes.Equal(d.Encode(), e.Encode()) {
+		t.Fatal("element modified on decoding error")
+	}
+}
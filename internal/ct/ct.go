@@ -0,0 +1,104 @@
+// SPDX-License-Group: MIT
+//
+// Copyright (C) 2022 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+// Package ct holds the constant-time primitives used across the module. Every selection, swap or comparison on secret
+// data goes through this package, so that it is the only constant-time surface to review.
+//
+// Conditions are ints that must be 0 or 1, and the results of comparisons are 0 or 1. None of the functions branch on
+// the contents of their inputs, and their timing only depends on input lengths.
+package ct
+
+// Mask returns 0xff if bit is 1, and 0 if bit is 0.
+func Mask(bit int) byte {
+	return byte(-bit)
+}
+
+// ByteEq returns 1 if a == b, and 0 otherwise.
+func ByteEq(a, b uint8) int {
+	x := uint32(a ^ b)
+	return int((x - 1) >> 31)
+}
+
+// Equal returns 1 if a and b have the same length and contents, and 0 otherwise.
+func Equal(a, b []byte) int {
+	if len(a) != len(b) {
+		return 0
+	}
+
+	var v byte
+	for i := range a {
+		v |= a[i] ^ b[i]
+	}
+
+	return ByteEq(v, 0)
+}
+
+// Cmov sets dst to src if cond is 1, and leaves it unchanged if cond is 0. dst and src must have the same length.
+func Cmov(dst, src []byte, cond int) {
+	if len(dst) != len(src) {
+		panic("ct: Cmov with slices of different lengths")
+	}
+
+	m := Mask(cond)
+	for i := range dst {
+		dst[i] ^= m & (dst[i] ^ src[i])
+	}
+}
+
+// Cswap swaps the contents of a and b if cond is 1, and leaves them unchanged if cond is 0. a and b must have the same
+// length.
+func Cswap(a, b []byte, cond int) {
+	if len(a) != len(b) {
+		panic("ct: Cswap with slices of different lengths")
+	}
+
+	m := Mask(cond)
+	for i := range a {
+		t := m & (a[i] ^ b[i])
+		a[i] ^= t
+		b[i] ^= t
+	}
+}
+
+// Lookup sets dst to table[index], reading every entry of the table. Out of range indexes leave dst unchanged. All
+// entries must have the length of dst.
+func Lookup(dst []byte, table [][]byte, index int) {
+	for i := range table {
+		Cmov(dst, table[i], intEq(i, index))
+	}
+}
+
+// LessThan returns 1 if a < b, and 0 otherwise, with a and b read as big-endian integers of the same length.
+func LessThan(a, b []byte) int {
+	if len(a) != len(b) {
+		panic("ct: LessThan with slices of different lengths")
+	}
+
+	// Going from the least to the most significant byte, each differing byte overrides the result so far.
+	var lt int
+	for i := len(a) - 1; i >= 0; i-- {
+		x, y := int(a[i]), int(b[i])
+		eq := intEq(x, y)
+		lt = eq*lt | (1-eq)*int(uint(x-y)>>(intSize-1))
+	}
+
+	return lt
+}
+
+// GreaterThan returns 1 if a > b, and 0 otherwise, with a and b read as big-endian integers of the same length.
+func GreaterThan(a, b []byte) int {
+	return LessThan(b, a)
+}
+
+const intSize = 32 << (^uint(0) >> 63)
+
+// intEq returns 1 if a == b, and 0 otherwise.
+func intEq(a, b int) int {
+	x := uint64(a ^ b)
+	return int(((x | -x) >> 63) ^ 1)
+}
@@ -0,0 +1,98 @@
+// SPDX-License-Group: MIT
+//
+// Copyright (C) 2022 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package ct
+
+import (
+	"bytes"
+	"crypto/rand"
+	"math/big"
+	"testing"
+)
+
+func TestByteEq(t *testing.T) {
+	for a := 0; a < 256; a++ {
+		for b := 0; b < 256; b++ {
+			expected := 0
+			if a == b {
+				expected = 1
+			}
+
+			if ByteEq(uint8(a), uint8(b)) != expected {
+				t.Fatalf("ByteEq(%d, %d) != %d", a, b, expected)
+			}
+		}
+	}
+}
+
+func TestEqual(t *testing.T) {
+	a := []byte{1, 2, 3}
+	if Equal(a, []byte{1, 2, 3}) != 1 || Equal(a, []byte{1, 2, 4}) != 0 || Equal(a, a[:2]) != 0 ||
+		Equal(nil, []byte{}) != 1 {
+		t.Fatal("unexpected Equal result")
+	}
+}
+
+func TestCmovCswap(t *testing.T) {
+	a, b := []byte{1, 2, 3}, []byte{4, 5, 6}
+
+	dst := bytes.Clone(a)
+	if Cmov(dst, b, 0); !bytes.Equal(dst, a) {
+		t.Fatal("Cmov with cond 0 modified dst")
+	}
+
+	if Cmov(dst, b, 1); !bytes.Equal(dst, b) {
+		t.Fatal("Cmov with cond 1 did not copy src")
+	}
+
+	x, y := bytes.Clone(a), bytes.Clone(b)
+	if Cswap(x, y, 0); !bytes.Equal(x, a) || !bytes.Equal(y, b) {
+		t.Fatal("Cswap with cond 0 swapped")
+	}
+
+	if Cswap(x, y, 1); !bytes.Equal(x, b) || !bytes.Equal(y, a) {
+		t.Fatal("Cswap with cond 1 did not swap")
+	}
+}
+
+func TestLookup(t *testing.T) {
+	table := [][]byte{{0, 0}, {1, 1}, {2, 2}, {3, 3}}
+
+	for i := range table {
+		dst := make([]byte, 2)
+		if Lookup(dst, table, i); !bytes.Equal(dst, table[i]) {
+			t.Fatalf("unexpected entry for index %d", i)
+		}
+	}
+
+	dst := []byte{9, 9}
+	if Lookup(dst, table, len(table)); !bytes.Equal(dst, []byte{9, 9}) {
+		t.Fatal("out of range index modified dst")
+	}
+}
+
+func TestLessThan(t *testing.T) {
+	for i := 0; i < 1000; i++ {
+		a, b := make([]byte, 8), make([]byte, 8)
+		_, _ = rand.Read(a)
+		_, _ = rand.Read(b)
+
+		// Exercise equal prefixes.
+		copy(b, a[:i%8])
+
+		cmp := new(big.Int).SetBytes(a).Cmp(new(big.Int).SetBytes(b))
+
+		if (LessThan(a, b) == 1) != (cmp < 0) || (GreaterThan(a, b) == 1) != (cmp > 0) {
+			t.Fatalf("unexpected comparison of %x and %x", a, b)
+		}
+
+		if LessThan(a, a) != 0 || GreaterThan(a, a) != 0 {
+			t.Fatal("strict comparisons of equal values must be 0")
+		}
+	}
+}
@@ -0,0 +1,127 @@
+// SPDX-License-Group: MIT
+//
+// Copyright (C) 2022 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package decaf448
+
+import (
+	"math/big"
+
+	"github.com/bytemare/decaf448/edwards448"
+)
+
+// LegacyCurve is a compatibility bridge exposing decaf448 through the affine, crypto/elliptic-style method set, for
+// legacy code that expects (x, y *big.Int) points. New code should use DecafElement.
+//
+// Decaf448 elements are classes of edwards448 points, and LegacyCurve represents each element by the affine
+// coordinates of its canonical representative, the point obtained by decoding its encoding. Only these coordinates
+// are valid inputs: all methods but IsOnCurve panic on other values. As with crypto/elliptic, scalars are big-endian
+// and reduced modulo the group order. This API is neither constant-time nor allocation-friendly.
+type LegacyCurve struct{}
+
+// IsOnCurve reports whether (x, y) are the coordinates of the canonical representative of a decaf448 element.
+func (LegacyCurve) IsOnCurve(x, y *big.Int) bool {
+	_, ok := fromAffine(x, y)
+	return ok
+}
+
+// Add returns the sum of (x1, y1) and (x2, y2).
+func (LegacyCurve) Add(x1, y1, x2, y2 *big.Int) (x, y *big.Int) {
+	p := mustFromAffine(x1, y1)
+	p.p.Add(&mustFromAffine(x2, y2).p)
+	p.encoded.Store(false)
+
+	return toAffine(p)
+}
+
+// Double returns 2 * (x1, y1).
+func (LegacyCurve) Double(x1, y1 *big.Int) (x, y *big.Int) {
+	p := mustFromAffine(x1, y1)
+	p.p.Double()
+	p.encoded.Store(false)
+
+	return toAffine(p)
+}
+
+// ScalarMult returns k * (x1, y1), where k is a big-endian integer.
+func (LegacyCurve) ScalarMult(x1, y1 *big.Int, k []byte) (x, y *big.Int) {
+	p := mustFromAffine(x1, y1)
+	p.p.ScalarMult(legacyScalar(k), &p.p)
+	p.encoded.Store(false)
+
+	return toAffine(p)
+}
+
+// ScalarBaseMult returns k * G, where G is the generator and k is a big-endian integer.
+func (LegacyCurve) ScalarBaseMult(k []byte) (x, y *big.Int) {
+	g := NewGroupElement()
+	g.p.ScalarMult(legacyScalar(k), &generator.p)
+
+	return toAffine(g)
+}
+
+// Generator returns the coordinates of the generator.
+func (LegacyCurve) Generator() (x, y *big.Int) {
+	return toAffine(generator)
+}
+
+func legacyScalar(k []byte) *edwards448.Element {
+	s := new(big.Int).SetBytes(k)
+	return edwards448.NewElement().SetInt(s.Mod(s, edwards448.GroupOrder()))
+}
+
+// toAffine returns the affine coordinates of the canonical representative of e.
+func toAffine(e *DecafElement) (x, y *big.Int) {
+	x, y, err := canonicalAffine(e)
+	if err != nil {
+		panic(err)
+	}
+
+	return x, y
+}
+
+// canonicalAffine returns the affine coordinates of the point obtained by decoding the encoding of e, which has Z = 1.
+func canonicalAffine(e *DecafElement) (x, y *big.Int, err error) {
+	var c DecafElement
+	if err = c.decode(e.Encode()); err != nil {
+		return nil, nil, err
+	}
+
+	return new(big.Int).SetBytes(c.p.X.Bytes()), new(big.Int).SetBytes(c.p.Y.Bytes()), nil
+}
+
+// fromAffine returns the element whose canonical representative has coordinates (x, y), and whether it exists.
+func fromAffine(x, y *big.Int) (*DecafElement, bool) {
+	p := edwards448.FieldOrder()
+	if x.Sign() < 0 || y.Sign() < 0 || x.Cmp(p) >= 0 || y.Cmp(p) >= 0 {
+		return nil, false
+	}
+
+	e := NewGroupElement()
+	e.p.X.SetInt(x)
+	e.p.Y.SetInt(y)
+	e.p.T.Multiply(&e.p.X, &e.p.Y)
+	e.p.Z.One()
+
+	// Encoding a valid point yields the encoding of its class, so (x, y) is valid only if it decodes back to itself.
+	// This rejects points off the curve, whose encodings either fail to decode or decode to points on the curve.
+	cx, cy, err := canonicalAffine(e)
+	if err != nil || cx.Cmp(x) != 0 || cy.Cmp(y) != 0 {
+		return nil, false
+	}
+
+	return e, true
+}
+
+func mustFromAffine(x, y *big.Int) *DecafElement {
+	e, ok := fromAffine(x, y)
+	if !ok {
+		panic(errInvalidAffine)
+	}
+
+	return e
+}
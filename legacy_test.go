@@ -0,0 +1,106 @@
+// SPDX-License-Group: MIT
+//
+// Copyright (C) 2022 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package decaf448
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+)
+
+func TestLegacyCurve(t *testing.T) {
+	var c LegacyCurve
+
+	a, b := HashToGroupN([]byte("a"), []byte("legacy"), 2)[0], HashToGroupN([]byte("b"), []byte("legacy"), 2)[0]
+	ax, ay := toAffine(a)
+	bx, by := toAffine(b)
+
+	if !c.IsOnCurve(ax, ay) || !c.IsOnCurve(bx, by) {
+		t.Fatal("canonical coordinates rejected")
+	}
+
+	if !bytes.Equal(mustFromAffine(ax, ay).Encode(), a.Encode()) {
+		t.Fatal("round trip through affine coordinates failed")
+	}
+
+	sum := NewAccumulator().Add(a).Add(b).Result()
+	if x, y := c.Add(ax, ay, bx, by); !bytes.Equal(mustFromAffine(x, y).Encode(), sum.Encode()) {
+		t.Fatal("unexpected sum")
+	}
+
+	double := NewAccumulator().Add(a).Add(a).Result()
+	if x, y := c.Double(ax, ay); !bytes.Equal(mustFromAffine(x, y).Encode(), double.Encode()) {
+		t.Fatal("unexpected double")
+	}
+
+	if x, y := c.ScalarMult(ax, ay, []byte{2}); !bytes.Equal(mustFromAffine(x, y).Encode(), double.Encode()) {
+		t.Fatal("unexpected scalar multiplication")
+	}
+
+	// k and k + l give the same result.
+	gx, gy := c.Generator()
+	k := new(big.Int).Add(big.NewInt(3), new(big.Int).SetBytes(reverseBytes(Order())))
+
+	x1, y1 := c.ScalarBaseMult([]byte{3})
+	x2, y2 := c.ScalarMult(gx, gy, k.Bytes())
+
+	if x1.Cmp(x2) != 0 || y1.Cmp(y2) != 0 {
+		t.Fatal("scalars are not reduced")
+	}
+
+	// The canonical representative of the identity element is (0, -1).
+	ix, iy := toAffine(NewAccumulator().Result())
+	if x, y := c.ScalarBaseMult(nil); x.Cmp(ix) != 0 || y.Cmp(iy) != 0 || ix.Sign() != 0 {
+		t.Fatal("0 * G is not the identity")
+	}
+
+	for _, p := range [][2]*big.Int{
+		{ax, new(big.Int).Add(ay, big.NewInt(1))},
+		{new(big.Int).Neg(ax), ay},
+		{big.NewInt(1), big.NewInt(1)},
+	} {
+		if c.IsOnCurve(p[0], p[1]) {
+			t.Fatal("invalid coordinates accepted")
+		}
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected panic on invalid coordinates")
+		}
+	}()
+
+	c.Double(big.NewInt(1), big.NewInt(1))
+}
+
+func TestLegacyCurveGenerator(t *testing.T) {
+	var c LegacyCurve
+
+	// RFC 9496, Appendix A.2, B[1] and B[2].
+	gx, gy := c.Generator()
+	if mustFromAffine(gx, gy).EncodeHex() != katGenerator {
+		t.Fatal("unexpected generator")
+	}
+
+	if x, y := c.ScalarBaseMult([]byte{2}); mustFromAffine(x, y).EncodeHex() != katGeneratorDouble {
+		t.Fatal("unexpected 2 * G")
+	}
+
+	if x, y := c.Double(gx, gy); mustFromAffine(x, y).EncodeHex() != katGeneratorDouble {
+		t.Fatal("unexpected double of G")
+	}
+}
+
+func reverseBytes(b []byte) []byte {
+	for i, j := 0, len(b)-1; i < j; i, j = i+1, j-1 {
+		b[i], b[j] = b[j], b[i]
+	}
+
+	return b
+}
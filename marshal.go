@@ -0,0 +1,56 @@
+// SPDX-License-Group: MIT
+//
+// Copyright (C) 2022 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package decaf448
+
+import (
+	"encoding"
+	"encoding/hex"
+)
+
+var (
+	_ encoding.BinaryAppender    = (*DecafElement)(nil)
+	_ encoding.TextAppender      = (*DecafElement)(nil)
+	_ encoding.BinaryMarshaler   = (*DecafElement)(nil)
+	_ encoding.BinaryUnmarshaler = (*DecafElement)(nil)
+	_ encoding.TextMarshaler     = (*DecafElement)(nil)
+	_ encoding.TextUnmarshaler   = (*DecafElement)(nil)
+)
+
+// AppendBinary appends the 56-byte canonical encoding of e to b. It does not allocate if b has enough capacity.
+func (e *DecafElement) AppendBinary(b []byte) ([]byte, error) {
+	return append(b, e.cachedEncoding()[:]...), nil
+}
+
+// MarshalBinary returns the 56-byte canonical encoding of e.
+func (e *DecafElement) MarshalBinary() ([]byte, error) {
+	return e.AppendBinary(make([]byte, 0, encodingLength))
+}
+
+// UnmarshalBinary sets e to the decoding of data, and returns an error if data is not a valid encoding. e is left
+// unchanged if an error is returned.
+func (e *DecafElement) UnmarshalBinary(data []byte) error {
+	return e.decode(data)
+}
+
+// AppendText appends the lowercase hexadecimal form of the canonical encoding of e to b, as returned by EncodeHex. It
+// does not allocate if b has enough capacity.
+func (e *DecafElement) AppendText(b []byte) ([]byte, error) {
+	return hex.AppendEncode(b, e.cachedEncoding()[:]), nil
+}
+
+// MarshalText returns the lowercase hexadecimal form of the canonical encoding of e.
+func (e *DecafElement) MarshalText() ([]byte, error) {
+	return e.AppendText(make([]byte, 0, 2*encodingLength))
+}
+
+// UnmarshalText sets e to the decoding of the hexadecimal text, as accepted by DecodeHex. e is left unchanged if an
+// error is returned.
+func (e *DecafElement) UnmarshalText(text []byte) error {
+	return e.DecodeHex(string(text))
+}
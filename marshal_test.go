@@ -0,0 +1,75 @@
+// SPDX-License-Group: MIT
+//
+// Copyright (C) 2022 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package decaf448_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/bytemare/decaf448"
+)
+
+func TestMarshalBinaryText(t *testing.T) {
+	e := randomElement(t)
+
+	prefix := []byte("prefix")
+
+	b, err := e.AppendBinary(bytes.Clone(prefix))
+	if err != nil || !bytes.Equal(b, append(bytes.Clone(prefix), e.Encode()...)) {
+		t.Fatal("unexpected AppendBinary output")
+	}
+
+	text, err := e.AppendText(bytes.Clone(prefix))
+	if err != nil || string(text) != "prefix"+e.EncodeHex() {
+		t.Fatal("unexpected AppendText output")
+	}
+
+	bin, _ := e.MarshalBinary()
+	txt, _ := e.MarshalText()
+
+	d := decaf448.NewGroupElement()
+	if err = d.UnmarshalBinary(bin); err != nil || d.Equal(e) != 1 {
+		t.Fatal("binary round trip failed")
+	}
+
+	d = decaf448.NewGroupElement()
+	if err = d.UnmarshalText(txt); err != nil || d.Equal(e) != 1 {
+		t.Fatal("text round trip failed")
+	}
+
+	if d.UnmarshalBinary(bin[:55]) == nil || d.UnmarshalText(txt[:110]) == nil {
+		t.Fatal("expected errors on truncated input")
+	}
+
+	// Elements can be used directly with encoding/json.
+	j, err := json.Marshal(map[string]*decaf448.DecafElement{"e": e})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var decoded map[string]*decaf448.DecafElement
+	if err = json.Unmarshal(j, &decoded); err != nil || decoded["e"].Equal(e) != 1 {
+		t.Fatal("JSON round trip failed")
+	}
+}
+
+func TestAppendAllocations(t *testing.T) {
+	e := randomElement(t)
+	e.Encode()
+
+	buf := make([]byte, 0, 2*112)
+
+	if n := testing.AllocsPerRun(100, func() {
+		buf, _ = e.AppendBinary(buf[:0])
+		buf, _ = e.AppendText(buf[:0])
+	}); n != 0 {
+		t.Fatalf("unexpected allocations: %v", n)
+	}
+}
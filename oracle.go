@@ -0,0 +1,59 @@
+// SPDX-License-Group: MIT
+//
+// Copyright (C) 2022 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package decaf448
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+)
+
+var errOracleLabelLength = fmt.Errorf("%w: oracle labels must not be longer than 65535 bytes", ErrInvalidLength)
+
+// RandomOracle derives elements from data under a protocol label. Every (protocol, label) pair gets its own domain
+// separation tag, so that the same tag is never reused across protocols or across uses within a protocol.
+type RandomOracle struct {
+	protocol string
+}
+
+// NewRandomOracle returns a RandomOracle bound to the protocol label, which should uniquely identify the protocol and
+// its version. It panics if protocol is empty or longer than 65535 bytes.
+func NewRandomOracle(protocol string) *RandomOracle {
+	if protocol == "" {
+		panic("empty protocol label")
+	}
+
+	if len(protocol) > math.MaxUint16 {
+		panic(errOracleLabelLength)
+	}
+
+	return &RandomOracle{protocol: protocol}
+}
+
+// DeriveElement hashes data to an element, using a domain separation tag built from the oracle's protocol and label.
+// It panics if label is longer than 65535 bytes.
+func (o *RandomOracle) DeriveElement(label string, data []byte) *DecafElement {
+	return NewGroupElement().HashToGroup(data, o.dst(label))
+}
+
+// dst returns the domain separation tag for label: the 2-byte length-prefixed protocol and label, followed by the
+// hash-to-group suite identifier. The lengths are checked to fit in the prefixes, which keeps the tag injective.
+func (o *RandomOracle) dst(label string) []byte {
+	if len(label) > math.MaxUint16 {
+		panic(errOracleLabelLength)
+	}
+
+	dst := make([]byte, 0, 4+len(o.protocol)+len(label)+len(h2cSuite))
+	dst = binary.BigEndian.AppendUint16(dst, uint16(len(o.protocol)))
+	dst = append(dst, o.protocol...)
+	dst = binary.BigEndian.AppendUint16(dst, uint16(len(label)))
+	dst = append(dst, label...)
+
+	return append(dst, h2cSuite...)
+}
@@ -0,0 +1,35 @@
+// SPDX-License-Group: MIT
+//
+// Copyright (C) 2022 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package decaf448
+
+import (
+	"math/big"
+
+	"github.com/bytemare/decaf448/edwards448"
+)
+
+// Order returns the 56-byte little-endian encoding of the prime group order
+// l = 2^446 - 13818066809895115352007386748515426880336692474882178609894547503885.
+func Order() []byte {
+	return littleEndian(edwards448.GroupOrder())
+}
+
+// FieldOrder returns the 56-byte little-endian encoding of the field order p = 2^448 - 2^224 - 1.
+func FieldOrder() []byte {
+	return littleEndian(edwards448.FieldOrder())
+}
+
+func littleEndian(i *big.Int) []byte {
+	out := i.FillBytes(make([]byte, encodingLength))
+	for j := 0; j < encodingLength/2; j++ {
+		out[j], out[encodingLength-1-j] = out[encodingLength-1-j], out[j]
+	}
+
+	return out
+}
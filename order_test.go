@@ -0,0 +1,83 @@
+// SPDX-License-Group: MIT
+//
+// Copyright (C) 2022 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package decaf448_test
+
+import (
+	"math/big"
+	"slices"
+	"testing"
+
+	"github.com/bytemare/decaf448"
+	"github.com/bytemare/decaf448/edwards448"
+)
+
+func fromLittleEndian(b []byte) *big.Int {
+	b = slices.Clone(b)
+	slices.Reverse(b)
+
+	return new(big.Int).SetBytes(b)
+}
+
+func TestOrders(t *testing.T) {
+	l := new(big.Int).Lsh(big.NewInt(1), 446)
+	c, _ := new(big.Int).SetString("13818066809895115352007386748515426880336692474882178609894547503885", 10)
+	l.Sub(l, c)
+
+	p := new(big.Int).Lsh(big.NewInt(1), 448)
+	p.Sub(p, new(big.Int).Lsh(big.NewInt(1), 224))
+	p.Sub(p, big.NewInt(1))
+
+	for _, test := range []struct {
+		name     string
+		expected *big.Int
+		encoded  []byte
+		value    *big.Int
+	}{
+		{"group", l, decaf448.Order(), edwards448.GroupOrder()},
+		{"field", p, decaf448.FieldOrder(), edwards448.FieldOrder()},
+	} {
+		if test.value.Cmp(test.expected) != 0 {
+			t.Fatalf("unexpected %s order", test.name)
+		}
+
+		if len(test.encoded) != 56 || fromLittleEndian(test.encoded).Cmp(test.expected) != 0 {
+			t.Fatalf("unexpected %s order encoding", test.name)
+		}
+
+		// The returned values must be copies.
+		test.encoded[0] ^= 1
+		test.value.SetInt64(0)
+	}
+
+	if edwards448.GroupOrder().Cmp(l) != 0 || edwards448.FieldOrder().Cmp(p) != 0 ||
+		fromLittleEndian(decaf448.Order()).Cmp(l) != 0 {
+		t.Fatal("orders were modified through returned values")
+	}
+}
+
+func TestParams(t *testing.T) {
+	params := decaf448.Params()
+
+	d := new(big.Int).Add(edwards448.FieldOrder(), big.NewInt(params.D))
+	if d.Cmp(new(big.Int).SetBytes(edwards448.D.Bytes())) != 0 {
+		t.Fatal("unexpected d")
+	}
+
+	if !slices.Equal(params.Order, decaf448.Order()) || !slices.Equal(params.FieldOrder, decaf448.FieldOrder()) {
+		t.Fatal("unexpected orders")
+	}
+
+	if params.ElementLength != len(randomElement(t).Encode()) || params.OneWayMapLength != 112 {
+		t.Fatal("unexpected lengths")
+	}
+
+	if params.HashToGroupSuite != "decaf448_XOF:SHAKE256_D448MAP_RO_" {
+		t.Fatal("unexpected suite")
+	}
+}
@@ -0,0 +1,60 @@
+// SPDX-License-Group: MIT
+//
+// Copyright (C) 2022 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package decaf448
+
+// Parameters describes the decaf448 group and the underlying curve.
+type Parameters struct {
+	// Name identifies the group.
+	Name string
+
+	// D is the edwards448 curve constant in a*x^2 + y^2 = 1 + d*x^2*y^2, with a = 1.
+	D int64
+
+	// Cofactor is the cofactor of edwards448. Decaf quotients it out, so every valid encoding is an element of the
+	// prime order group, and no cofactor clearing is needed on decoded or hashed elements.
+	Cofactor int
+
+	// Order and FieldOrder are the little-endian encodings of the group order l and the field prime p.
+	Order      []byte
+	FieldOrder []byte
+
+	// ElementLength is the length of an encoded element, and ScalarLength that of an encoded scalar.
+	ElementLength int
+	ScalarLength  int
+
+	// UniformBytesLength is the length of the input to SetUniformBytes.
+	UniformBytesLength int
+
+	// OneWayMapLength is the length of the input to OneWayMap.
+	//
+	// Deprecated: use UniformBytesLength.
+	OneWayMapLength int
+
+	// HashToGroupSuite is the RFC 9380 suite identifier used by HashToGroup, and MapToGroupLabel the domain separation
+	// prefix used by MapToGroup.
+	HashToGroupSuite string
+	MapToGroupLabel  string
+}
+
+// Params returns the parameters of the decaf448 group.
+func Params() Parameters {
+	return Parameters{
+		Name:               "decaf448",
+		D:                  -39081,
+		Cofactor:           4,
+		Order:              Order(),
+		FieldOrder:         FieldOrder(),
+		ElementLength:      encodingLength,
+		ScalarLength:       encodingLength,
+		UniformBytesLength: uniformBytesLength,
+		OneWayMapLength:    uniformBytesLength,
+		HashToGroupSuite:   h2cSuite,
+		MapToGroupLabel:    mapToGroupLabel,
+	}
+}
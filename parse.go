@@ -0,0 +1,84 @@
+// SPDX-License-Group: MIT
+//
+// Copyright (C) 2022 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package decaf448
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+)
+
+var errUnknownFormat = fmt.Errorf("%w: unrecognized element string format", ErrInvalidLength)
+
+// ParseElement decodes an element from its string form, which may be the hexadecimal encoding of its 56 bytes (any
+// case), or their standard or URL-safe base64 encoding, with or without padding. Surrounding whitespace is ignored.
+// The format is detected from the string length, which is distinct for each of them, so detection is unambiguous.
+// The decoded bytes must be a valid canonical encoding.
+func ParseElement(s string) (*DecafElement, error) {
+	s = strings.TrimSpace(s)
+
+	var (
+		buf []byte
+		err error
+	)
+
+	switch len(s) {
+	case 2 * encodingLength:
+		e := NewGroupElement()
+		if err = e.DecodeHex(s); err != nil {
+			return nil, err
+		}
+
+		return e, nil
+	case base64.StdEncoding.EncodedLen(encodingLength):
+		buf, err = decodeBase64(base64.StdEncoding, base64.URLEncoding, s)
+	case base64.RawStdEncoding.EncodedLen(encodingLength):
+		buf, err = decodeBase64(base64.RawStdEncoding, base64.RawURLEncoding, s)
+	default:
+		return nil, errUnknownFormat
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	e := NewGroupElement()
+	if err = e.decode(buf); err != nil {
+		return nil, err
+	}
+
+	return e, nil
+}
+
+// MustParseElement is like ParseElement but panics on invalid input. It is meant for known-good constants, e.g. in
+// package-level variables and tests.
+func MustParseElement(s string) *DecafElement {
+	e, err := ParseElement(s)
+	if err != nil {
+		panic(err)
+	}
+
+	return e
+}
+
+// decodeBase64 decodes s with the url alphabet if it contains one of its specific characters, and with std otherwise.
+// Decoding is strict, rejecting non-zero trailing bits.
+func decodeBase64(std, url *base64.Encoding, s string) ([]byte, error) {
+	enc := std
+	if strings.ContainsAny(s, "-_") {
+		enc = url
+	}
+
+	b, err := enc.Strict().DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidFormat, err)
+	}
+
+	return b, nil
+}
@@ -0,0 +1,101 @@
+// SPDX-License-Group: MIT
+//
+// Copyright (C) 2022 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package decaf448_test
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/bytemare/decaf448"
+)
+
+func TestParseElement(t *testing.T) {
+	// Look for an encoding that uses the URL-specific base64 characters, to exercise both alphabets.
+	e := randomElement(t)
+	for !strings.ContainsAny(base64.URLEncoding.EncodeToString(e.Encode()), "-_") {
+		e = randomElement(t)
+	}
+
+	encoded := e.Encode()
+
+	for _, s := range []string{
+		hex.EncodeToString(encoded),
+		strings.ToUpper(hex.EncodeToString(encoded)),
+		base64.StdEncoding.EncodeToString(encoded),
+		base64.RawStdEncoding.EncodeToString(encoded),
+		base64.URLEncoding.EncodeToString(encoded),
+		" " + base64.RawURLEncoding.EncodeToString(encoded) + "\n",
+	} {
+		p, err := decaf448.ParseElement(s)
+		if err != nil {
+			t.Fatalf("%q: %v", s, err)
+		}
+
+		if !bytes.Equal(p.Encode(), encoded) {
+			t.Fatalf("%q: unexpected element", s)
+		}
+	}
+
+	invalid := bytes.Clone(encoded)
+	invalid[0] |= 1
+
+	// The last character carries 2 bits of padding, which must be zero.
+	trailing := []byte(base64.RawStdEncoding.EncodeToString(encoded))
+	trailing[len(trailing)-1] = 'B'
+
+	for _, test := range []struct {
+		input    string
+		expected error
+	}{
+		{"", decaf448.ErrInvalidLength},
+		{hex.EncodeToString(encoded[:55]), decaf448.ErrInvalidLength},
+		{hex.EncodeToString(invalid), decaf448.ErrNonCanonical},
+		{base64.StdEncoding.EncodeToString(invalid), decaf448.ErrNonCanonical},
+		{base64.RawStdEncoding.EncodeToString(invalid), decaf448.ErrNonCanonical},
+		{strings.Repeat("zz", 56), decaf448.ErrInvalidFormat},
+		{strings.Repeat("!", 76), decaf448.ErrInvalidFormat},
+		{string(trailing), decaf448.ErrInvalidFormat},
+	} {
+		if _, err := decaf448.ParseElement(test.input); !errors.Is(err, test.expected) {
+			t.Fatalf("%q: expected %v, got %v", test.input, test.expected, err)
+		}
+	}
+}
+
+func TestMustDecodeElement(t *testing.T) {
+	e := randomElement(t)
+
+	if !bytes.Equal(decaf448.MustDecodeElement(e.Encode()).Encode(), e.Encode()) ||
+		!bytes.Equal(decaf448.MustParseElement(e.EncodeHex()).Encode(), e.Encode()) {
+		t.Fatal("unexpected element")
+	}
+
+	if d, err := decaf448.DecodeElement(e.Encode()[:55]); d != nil || !errors.Is(err, decaf448.ErrInvalidLength) {
+		t.Fatalf("unexpected result %v, %v", d, err)
+	}
+
+	for _, f := range []func(){
+		func() { decaf448.MustDecodeElement(make([]byte, 55)) },
+		func() { decaf448.MustParseElement("") },
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Fatal("expected panic")
+				}
+			}()
+
+			f()
+		}()
+	}
+}
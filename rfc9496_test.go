@@ -0,0 +1,302 @@
+// SPDX-License-Group: MIT
+//
+// Copyright (C) 2022 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package decaf448
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"testing"
+	"unsafe"
+
+	"github.com/bytemare/decaf448/edwards448"
+)
+
+// rfcMultiples are the encodings of B[0] to B[15], the multiples of the generator in RFC 9496, Appendix A.2.1.
+var rfcMultiples = []string{
+	"0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000",
+	"6666666666666666666666666666666666666666666666666666666633333333333333333333333333333333333333333333333333333333",
+	"c898eb4f87f97c564c6fd61fc7e49689314a1f818ec85eeb3bd5514ac816d38778f69ef347a89fca817e66defdedce178c7cc709b2116e75",
+	"a0c09bf2ba7208fda0f4bfe3d0f5b29a543012306d43831b5adc6fe7f8596fa308763db15468323b11cf6e4aeb8c18fe44678f44545a69bc",
+	"b46f1836aa287c0a5a5653f0ec5ef9e903f436e21c1570c29ad9e5f596da97eeaf17150ae30bcb3174d04bc2d712c8c7789d7cb4fda138f4",
+	"1c5bbecf4741dfaae79db72dface00eaaac502c2060934b6eaaeca6a20bd3da9e0be8777f7d02033d1b15884232281a41fc7f80eed04af5e",
+	"86ff0182d40f7f9edb7862515821bd67bfd6165a3c44de95d7df79b8779ccf6460e3c68b70c16aaa280f2d7b3f22d745b97a89906cfc476c",
+	"502bcb6842eb06f0e49032bae87c554c031d6d4d2d7694efbf9c468d48220c50f8ca28843364d70cee92d6fe246e61448f9db9808b3b2408",
+	"0c9810f1e2ebd389caa789374d78007974ef4d17227316f40e578b336827da3f6b482a4794eb6a3975b971b5e1388f52e91ea2f1bcb0f912",
+	"20d41d85a18d5657a29640321563bbd04c2ffbd0a37a7ba43a4f7d263ce26faf4e1f74f9f4b590c69229ae571fe37fa639b5b8eb48bd9a55",
+	"e6b4b8f408c7010d0601e7eda0c309a1a42720d6d06b5759fdc4e1efe22d076d6c44d42f508d67be462914d28b8edce32e7094305164af17",
+	"be88bbb86c59c13d8e9d09ab98105f69c2d1dd134dbcd3b0863658f53159db64c0e139d180f3c89b8296d0ae324419c06fa87fc7daaf34c1",
+	"a456f9369769e8f08902124a0314c7a06537a06e32411f4f93415950a17badfa7442b6217434a3a05ef45be5f10bd7b2ef8ea00c431edec5",
+	"186e452c4466aa4383b4c00210d52e7922dbf9771e8b47e229a9b7b73c8d10fd7ef0b6e41530f91f24a3ed9ab71fa38b98b2fe4746d51d68",
+	"4ae7fdcae9453f195a8ead5cbe1a7b9699673b52c40ab27927464887be53237f7f3a21b938d40d0ec9e15b1d5130b13ffed81373a53e2b43",
+	"841981c3bfeec3f60cfeca75d9d8dc17f46cf0106f2422b59aec580a58f342272e3a5e575a055ddb051390c54c24c6ecb1e0aceb075f6056",
+}
+
+// rfcElementDerivation are the element derivation vectors of RFC 9496, Appendix A.2.3.
+var rfcElementDerivation = []struct {
+	input, output string
+}{
+	{
+		"cbb8c991fd2f0b7e1913462d6463e4fd2ce4ccdd28274dc2ca1f4165d5ee6cdccea57be3416e166fd06718a31af45a2f8e987e301be59ae6" +
+			"673e963001dbbda80df47014a21a26d6c7eb4ebe0312aa6fffb8d1b26bc62ca40ed51f8057a635a02c2b8c83f48fa6a2d70f58a1185902c0",
+		"0c709c9607dbb01c94513358745b7c23953d03b33e39c7234e268d1d6e24f34014ccbc2216b965dd231d5327e591dc3c0e8844ccfd568848",
+	},
+	{
+		"b6d8da654b13c3101d6634a231569e6b85961c3f4b460a08ac4a5857069576b64428676584baa45b97701be6d0b0ba18ac28d443403b4569" +
+			"9ea0fbd1164f5893d39ad8f29e48e399aec5902508ea95e33bc1e9e4620489d684eb5c26bc1ad1e09aba61fabc2cdfee0b6b6862ffc8e55a",
+		"76ab794e28ff1224c727fa1016bf7f1d329260b7218a39aea2fdb17d8bd9119017b093d641cedf74328c327184dc6f2a64bd90eddccfcdab",
+	},
+	{
+		"36a69976c3e5d74e4904776993cbac27d10f25f5626dd45c51d15dcf7b3e6a5446a6649ec912a56895d6baa9dc395ce9e34b868d9fb2c1fc" +
+			"72eb6495702ea4f446c9b7a188a4e0826b1506b0747a6709f37988ff1aeb5e3788d5076ccbb01a4bc6623c92ff147a1e21b29cc3fdd0e0f4",
+		"c8d7ac384143500e50890a1c25d643343accce584caf2544f9249b2bf4a6921082be0e7f3669bb5ec24535e6c45621e1f6dec676edd8b664",
+	},
+	{
+		"d5938acbba432ecd5617c555a6a777734494f176259bff9dab844c81aadcf8f7abd1a9001d89c7008c1957272c1786a4293bb0ee7cb37cf3" +
+			"988e2513b14e1b75249a5343643d3c5e5545a0c1a2a4d3c685927c38bc5e5879d68745464e2589e000b31301f1dfb7471a4f1300d6fd0f99",
+		"62beffc6b8ee11ccd79dbaac8f0252c750eb052b192f41eeecb12f2979713b563caf7d22588eca5e80995241ef963e7ad7cb7962f343a973",
+	},
+	{
+		"4dec58199a35f531a5f0a9f71a53376d7b4bdd6bbd2904234a8ea65bbacbce2a542291378157a8f4be7b6a092672a34d85e473b26ccfbd4c" +
+			"dc6739783dc3f4f6ee3537b7aed81df898c7ea0ae89a15b5559596c2a5eeacf8b2b362f3db2940e3798b63203cae77c4683ebaed71533e51",
+		"f4ccb31d263731ab88bed634304956d2603174c66da38742053fa37dd902346c3862155d68db63be87439e3d68758ad7268e239d39c4fd3b",
+	},
+	{
+		"df2aa1536abb4acab26efa538ce07fd7bca921b13e17bc5ebcba7d1b6b733deda1d04c220f6b5ab35c61b6bcb15808251cab909a01465b8a" +
+			"e3fc770850c66246d5a9eae9e2877e0826e2b8dc1bc08009590bc6778a84e919fbd28e02a0f9c49b48dc689eb5d5d922dc01469968ee81b5",
+		"7e79b00e8e0a76a67c0040f62713b8b8c6d6f05e9c6d02592e8a22ea896f5deacc7c7df5ed42beae6fedb9000285b482aa504e279fd49c32",
+	},
+	{
+		"e9fb440282e07145f1f7f5ecf3c273212cd3d26b836b41b02f108431488e5e84bd15f2418b3d92a3380dd66a374645c2a995976a015632d3" +
+			"6a6c2189f202fc766e1c82f50ad9189be190a1f0e8f9b9e69c9c18cc98fdd885608f68bf0fdedd7b894081a63f70016a8abf04953affbefa",
+		"20b171cb16be977f15e013b9752cf86c54c631c4fc8cbf7c03c4d3ac9b8e8640e7b0e9300b987fe0ab5044669314f6ed1650ae037db853f1",
+	},
+}
+
+func TestMultiplesOfGenerator(t *testing.T) {
+	acc := NewAccumulator()
+
+	for i, want := range rfcMultiples {
+		if got := NewGroupElement().ScalarBaseMult(NewScalar().SetUint64(uint64(i))).EncodeHex(); got != want {
+			t.Fatalf("B[%d]: want %s, got %s", i, want, got)
+		}
+
+		if got := acc.Result().EncodeHex(); got != want {
+			t.Fatalf("B[%d] by addition: want %s, got %s", i, want, got)
+		}
+
+		// Decode, and re-encode without the cache seeded by decoding.
+		e := MustDecodeElement(mustHex(want))
+		e.encoded.Store(false)
+
+		if got := e.EncodeHex(); got != want {
+			t.Fatalf("B[%d] does not re-encode to itself, got %s", i, got)
+		}
+
+		acc.Add(generator)
+	}
+}
+
+// invalidEncodings are 56-byte encodings that decoding must reject, grouped like the invalid encodings of RFC 9496,
+// Appendix A.2.2. They are constructed for each failure condition of decoding, not copied from the RFC: the RFC list
+// still has to be added as is.
+var invalidEncodings = []struct {
+	name, encoding string
+	expected       error
+}{
+	// Non-canonical field encodings.
+	{
+		"p",
+		"fffffffffffffffffffffffffffffffffffffffffffffffffffffffffeffffffffffffffffffffffffffffffffffffffffffffffffffffff",
+		ErrNonCanonical,
+	},
+	{
+		"p + 1",
+		"00000000000000000000000000000000000000000000000000000000ffffffffffffffffffffffffffffffffffffffffffffffffffffffff",
+		ErrNonCanonical,
+	},
+	{
+		"2^448 - 1",
+		"ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff",
+		ErrNonCanonical,
+	},
+	// Negative field elements.
+	{
+		"1",
+		"0100000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000",
+		ErrNonCanonical,
+	},
+	{
+		"p - 2",
+		"fdfffffffffffffffffffffffffffffffffffffffffffffffffffffffeffffffffffffffffffffffffffffffffffffffffffffffffffffff",
+		ErrNonCanonical,
+	},
+	// Non-square x^2.
+	{
+		"4",
+		"0400000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000",
+		ErrNotOnGroup,
+	},
+	{
+		"0x8e repeated",
+		"8e8e8e8e8e8e8e8e8e8e8e8e8e8e8e8e8e8e8e8e8e8e8e8e8e8e8e8e8e8e8e8e8e8e8e8e8e8e8e8e8e8e8e8e8e8e8e8e8e8e8e8e8e8e8e8e",
+		ErrNotOnGroup,
+	},
+}
+
+func TestInvalidEncodings(t *testing.T) {
+	for _, test := range invalidEncodings {
+		if err := NewGroupElement().decode(mustHex(test.encoding)); !errors.Is(err, test.expected) {
+			t.Fatalf("%s: expected %v, got %v", test.name, test.expected, err)
+		}
+	}
+
+	for _, test := range []struct {
+		name  string
+		input []byte
+	}{
+		{"empty", nil},
+		{"short", make([]byte, 55)},
+		{"long", make([]byte, 57)},
+	} {
+		if err := NewGroupElement().decode(test.input); !errors.Is(err, ErrInvalidLength) {
+			t.Fatalf("%s: expected %v, got %v", test.name, ErrInvalidLength, err)
+		}
+	}
+}
+
+func TestEqual(t *testing.T) {
+	a := NewGroupElement().HashToGroup([]byte("a"), []byte("equal"))
+	b := NewGroupElement().HashToGroup([]byte("b"), []byte("equal"))
+
+	if a.Equal(a) != 1 || a.Equal(b) != 0 {
+		t.Fatal("unexpected equality")
+	}
+
+	// Adding the 2-torsion point (0, -1) gives (-x, -y), another representative of the same element.
+	c := NewGroupElement()
+	c.p.Set(&a.p)
+	c.p.X.Negate(&c.p.X)
+	c.p.Y.Negate(&c.p.Y)
+
+	if a.Equal(c) != 1 || !bytes.Equal(a.Encode(), c.Encode()) {
+		t.Fatal("equivalent representatives are not equal")
+	}
+
+	// Different projective representations of the same point.
+	d := NewAccumulator().Add(a).Add(b).Sub(b).Result()
+	if a.Equal(d) != 1 {
+		t.Fatal("equivalent representations are not equal")
+	}
+
+	// A new element is the identity, and must not compare equal to every element.
+	fresh := NewGroupElement()
+	if fresh.Equal(generator) != 0 || generator.Equal(fresh) != 0 {
+		t.Fatal("new element equals the generator")
+	}
+
+	if fresh.Equal(NewAccumulator().Result()) != 1 {
+		t.Fatal("new element is not the identity")
+	}
+}
+
+func TestElementDerivation(t *testing.T) {
+	for i, test := range rfcElementDerivation {
+		if got := NewGroupElement().SetUniformBytes(mustHex(test.input)).EncodeHex(); got != test.output {
+			t.Fatalf("vector %d: want %s, got %s", i, test.output, got)
+		}
+	}
+}
+
+func TestSetUniformBytes(t *testing.T) {
+	input := expandXOF([]byte("msg"), []byte("uniform"), 112)
+
+	if !bytes.Equal(NewGroupElement().SetUniformBytes(input).Encode(), NewGroupElement().OneWayMap(input).Encode()) {
+		t.Fatal("OneWayMap differs from SetUniformBytes")
+	}
+
+	for _, length := range []int{0, 111, 113} {
+		func() {
+			defer func() {
+				if err, _ := recover().(error); !errors.Is(err, ErrInvalidLength) {
+					t.Fatalf("expected panic for length %d", length)
+				}
+			}()
+
+			NewGroupElement().SetUniformBytes(make([]byte, length))
+		}()
+	}
+}
+
+func TestDecodeSeedsCache(t *testing.T) {
+	encoded := NewGroupElement().HashToGroup([]byte("msg"), []byte("cache")).Encode()
+
+	e := NewGroupElement()
+	if err := e.decode(encoded); err != nil {
+		t.Fatal(err)
+	}
+
+	if !e.encoded.Load() || !bytes.Equal(e.encoding[:], encoded) {
+		t.Fatal("decoding did not seed the encoding cache")
+	}
+
+	// The seeded encoding must match a fresh computation.
+	e.encoded.Store(false)
+	if !bytes.Equal(e.Encode(), encoded) {
+		t.Fatal("seeded encoding differs from the computed one")
+	}
+
+	// A failed decoding leaves the element and its cache untouched.
+	invalid := bytes.Clone(encoded)
+	invalid[0] |= 1
+
+	if err := e.decode(invalid); err == nil || !bytes.Equal(e.Encode(), encoded) {
+		t.Fatal("failed decoding modified the element")
+	}
+}
+
+// coordinateWords returns the whole backing arrays of the coordinates of e. Element only holds a big.Int, so it can be
+// read as one.
+func coordinateWords(e *DecafElement) [][]big.Word {
+	var words [][]big.Word
+
+	for _, c := range []*edwards448.Element{&e.p.X, &e.p.Y, &e.p.Z, &e.p.T} {
+		w := (*big.Int)(unsafe.Pointer(c)).Bits()
+		words = append(words, w[:cap(w)])
+	}
+
+	return words
+}
+
+func TestResetWipes(t *testing.T) {
+	e := NewGroupElement().HashToGroup([]byte("secret"), []byte("reset"))
+	e.Encode()
+
+	words := coordinateWords(e)
+	e.Reset()
+
+	// The identity is (0, 1, 1, 0), so only the lowest words of Y and Z may be set.
+	for i, w := range words {
+		for j, v := range w {
+			if v != 0 && !(j == 0 && (i == 1 || i == 2)) {
+				t.Fatalf("coordinate %d was not wiped", i)
+			}
+		}
+	}
+
+	if e.encoding != [encodingLength]byte{} || !bytes.Equal(e.Encode(), NewAccumulator().Result().Encode()) {
+		t.Fatal("cached encoding was not cleared")
+	}
+}
+
+func TestSelfTest(t *testing.T) {
+	if err := SelfTest(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,178 @@
+// SPDX-License-Group: MIT
+//
+// Copyright (C) 2022 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package decaf448
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/bytemare/decaf448/edwards448"
+	"github.com/bytemare/decaf448/internal/ct"
+)
+
+// ScalarEncoding is the type of scalar encodings returned by Scalar.Encode and accepted by Scalar.Decode. Like
+// ElementEncoding, it accepts plain []byte values, but passing an element encoding where a scalar encoding is expected,
+// or the converse, is a compile-time error.
+type ScalarEncoding []byte
+
+var (
+	groupOrder = edwards448.GroupOrder()
+
+	// groupOrderMinusTwo is the exponent for inversion by Fermat's little theorem.
+	groupOrderMinusTwo = new(big.Int).Sub(groupOrder, big.NewInt(2))
+
+	errScalarNonCanonical = fmt.Errorf("%w: scalar encoding is not lower than the group order", ErrScalarOutOfRange)
+)
+
+// Scalar is an integer modulo the prime group order l. The zero value is 0, and is ready to use. Arithmetic is backed
+// by math/big like the field, and is not constant-time.
+type Scalar struct {
+	s big.Int
+}
+
+// NewScalar returns a new scalar set to 0.
+func NewScalar() *Scalar {
+	return new(Scalar)
+}
+
+// Zero sets s to 0.
+func (s *Scalar) Zero() *Scalar {
+	s.s.SetUint64(0)
+	return s
+}
+
+// One sets s to 1.
+func (s *Scalar) One() *Scalar {
+	s.s.SetUint64(1)
+	return s
+}
+
+// SetUint64 sets s to i.
+func (s *Scalar) SetUint64(i uint64) *Scalar {
+	s.s.SetUint64(i)
+	return s
+}
+
+// Set sets s to u.
+func (s *Scalar) Set(u *Scalar) *Scalar {
+	s.s.Set(&u.s)
+	return s
+}
+
+// Copy returns a new scalar set to s.
+func (s *Scalar) Copy() *Scalar {
+	return NewScalar().Set(s)
+}
+
+// Add sets s = u + v mod l.
+func (s *Scalar) Add(u, v *Scalar) *Scalar {
+	return s.reduce(s.s.Add(&u.s, &v.s))
+}
+
+// Subtract sets s = u - v mod l.
+func (s *Scalar) Subtract(u, v *Scalar) *Scalar {
+	return s.reduce(s.s.Sub(&u.s, &v.s))
+}
+
+// Multiply sets s = u * v mod l.
+func (s *Scalar) Multiply(u, v *Scalar) *Scalar {
+	return s.reduce(s.s.Mul(&u.s, &v.s))
+}
+
+// Negate sets s = -u mod l.
+func (s *Scalar) Negate(u *Scalar) *Scalar {
+	return s.reduce(s.s.Neg(&u.s))
+}
+
+// Invert sets s = 1/u mod l, and to 0 if u is 0.
+func (s *Scalar) Invert(u *Scalar) *Scalar {
+	s.s.Exp(&u.s, groupOrderMinusTwo, groupOrder)
+	return s
+}
+
+// IsZero returns 1 if s is 0, and 0 otherwise.
+func (s *Scalar) IsZero() int {
+	return s.Equal(NewScalar())
+}
+
+// Equal returns 1 if s and u are equal, and 0 otherwise.
+func (s *Scalar) Equal(u *Scalar) int {
+	var a, b [encodingLength]byte
+	s.s.FillBytes(a[:])
+	u.s.FillBytes(b[:])
+
+	return ct.Equal(a[:], b[:])
+}
+
+func (s *Scalar) reduce(x *big.Int) *Scalar {
+	s.s.Mod(x, groupOrder)
+	return s
+}
+
+// Encode returns the canonical 56-byte little-endian encoding of s.
+func (s *Scalar) Encode() ScalarEncoding {
+	return littleEndian(&s.s)
+}
+
+// Decode sets s to the decoding of input, and panics if input is not the canonical 56-byte little-endian encoding of
+// a scalar, i.e. a value lower than l.
+func (s *Scalar) Decode(input ScalarEncoding) *Scalar {
+	if err := s.decode(input); err != nil {
+		panic(err)
+	}
+
+	return s
+}
+
+// DecodeScalar returns a new scalar decoded from input, and an error if input is not a canonical encoding.
+func DecodeScalar(input ScalarEncoding) (*Scalar, error) {
+	s := NewScalar()
+	if err := s.decode(input); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func (s *Scalar) decode(input []byte) error {
+	if len(input) != encodingLength {
+		return ErrInvalidLength
+	}
+
+	var i big.Int
+	if i.SetBytes(reverseCopy(input)).Cmp(groupOrder) >= 0 {
+		return errScalarNonCanonical
+	}
+
+	s.s.Set(&i)
+
+	return nil
+}
+
+func reverseCopy(b []byte) []byte {
+	out := make([]byte, len(b))
+	for i := range b {
+		out[len(b)-1-i] = b[i]
+	}
+
+	return out
+}
+
+// ScalarMult sets e = s * u.
+func (e *DecafElement) ScalarMult(s *Scalar, u *DecafElement) *DecafElement {
+	e.p.ScalarMult(edwards448.NewElement().SetInt(&s.s), &u.p)
+	e.encoded.Store(false)
+
+	return e
+}
+
+// ScalarBaseMult sets e = s * G, where G is the generator of the group.
+func (e *DecafElement) ScalarBaseMult(s *Scalar) *DecafElement {
+	return e.ScalarMult(s, generator)
+}
@@ -0,0 +1,119 @@
+// SPDX-License-Group: MIT
+//
+// Copyright (C) 2022 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package decaf448_test
+
+import (
+	"bytes"
+	"crypto/rand"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/bytemare/decaf448"
+)
+
+func randomScalar(t *testing.T) (*decaf448.Scalar, *big.Int) {
+	t.Helper()
+
+	l := fromLittleEndian(decaf448.Order())
+
+	i, err := rand.Int(rand.Reader, l)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	enc := make([]byte, 56)
+	i.FillBytes(enc)
+
+	for j, k := 0, len(enc)-1; j < k; j, k = j+1, k-1 {
+		enc[j], enc[k] = enc[k], enc[j]
+	}
+
+	return decaf448.NewScalar().Decode(enc), i
+}
+
+func TestScalarArithmetic(t *testing.T) {
+	l := fromLittleEndian(decaf448.Order())
+
+	for range 10 {
+		a, ia := randomScalar(t)
+		b, ib := randomScalar(t)
+
+		check := func(name string, s *decaf448.Scalar, want *big.Int) {
+			t.Helper()
+
+			if got := fromLittleEndian(s.Encode()); got.Cmp(want.Mod(want, l)) != 0 {
+				t.Fatalf("%s: got %v, want %v", name, got, want)
+			}
+		}
+
+		check("add", decaf448.NewScalar().Add(a, b), new(big.Int).Add(ia, ib))
+		check("subtract", decaf448.NewScalar().Subtract(a, b), new(big.Int).Sub(ia, ib))
+		check("multiply", decaf448.NewScalar().Multiply(a, b), new(big.Int).Mul(ia, ib))
+		check("negate", decaf448.NewScalar().Negate(a), new(big.Int).Neg(ia))
+
+		if decaf448.NewScalar().Multiply(a, decaf448.NewScalar().Invert(a)).Equal(decaf448.NewScalar().One()) != 1 {
+			t.Fatal("a * 1/a != 1")
+		}
+	}
+
+	if decaf448.NewScalar().Invert(decaf448.NewScalar()).IsZero() != 1 {
+		t.Fatal("expected the inverse of 0 to be 0")
+	}
+}
+
+func TestScalarEncoding(t *testing.T) {
+	a, _ := randomScalar(t)
+
+	b, err := decaf448.DecodeScalar(a.Encode())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if a.Equal(b) != 1 {
+		t.Fatal("scalar encoding round trip failed")
+	}
+
+	if _, err := decaf448.DecodeScalar(decaf448.Order()); !errors.Is(err, decaf448.ErrScalarOutOfRange) {
+		t.Fatalf("expected ErrScalarOutOfRange for l, got %v", err)
+	}
+
+	if _, err := decaf448.DecodeScalar(make([]byte, 55)); !errors.Is(err, decaf448.ErrInvalidLength) {
+		t.Fatalf("expected ErrInvalidLength, got %v", err)
+	}
+}
+
+func TestScalarMult(t *testing.T) {
+	g := decaf448.MustDecodeElement(rfcB1)
+
+	if decaf448.NewGroupElement().ScalarBaseMult(decaf448.NewScalar().One()).Equal(g) != 1 {
+		t.Fatal("1 * G != G")
+	}
+
+	if !bytes.Equal(decaf448.NewGroupElement().ScalarBaseMult(decaf448.NewScalar().SetUint64(2)).Encode(), rfcB2) {
+		t.Fatal("2 * G is not RFC 9496 B[2]")
+	}
+
+	a, _ := randomScalar(t)
+	b, _ := randomScalar(t)
+
+	// (a * b) * G == a * (b * G)
+	ab := decaf448.NewGroupElement().ScalarBaseMult(decaf448.NewScalar().Multiply(a, b))
+	bG := decaf448.NewGroupElement().ScalarBaseMult(b)
+
+	if decaf448.NewGroupElement().ScalarMult(a, bG).Equal(ab) != 1 {
+		t.Fatal("(a * b) * G != a * (b * G)")
+	}
+
+	// (a - a) * G is the identity.
+	zero := decaf448.NewGroupElement().ScalarBaseMult(decaf448.NewScalar().Subtract(a, a))
+	if !bytes.Equal(zero.Encode(), make([]byte, 56)) {
+		t.Fatal("(a - a) * G is not the identity")
+	}
+}
@@ -0,0 +1,103 @@
+// SPDX-License-Group: MIT
+//
+// Copyright (C) 2022 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package decaf448
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha3"
+	"errors"
+)
+
+const (
+	// sealedBoxLabel domain separates the key derivation of sealed boxes.
+	sealedBoxLabel = "decaf448_SealedBox_AES256GCM"
+
+	sealedBoxKeyLength   = 32
+	sealedBoxNonceLength = 12
+	sealedBoxTagLength   = 16
+)
+
+var errSealedBox = errors.New("invalid sealed box")
+
+// Seal encrypts msg to recipient without sender authentication, in the style of NaCl sealed boxes. A fresh ephemeral
+// key pair is generated, and the AES-256-GCM key and nonce are derived with SHAKE256 from the ephemeral and
+// recipient public keys and the shared secret. The box is the ephemeral public key followed by the ciphertext, and is
+// 72 bytes longer than msg.
+func Seal(recipient *PublicKey, msg []byte) ([]byte, error) {
+	ephemeral, err := ECDH().GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	shared, err := ephemeral.ECDH(recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, nonce := sealedBoxAEAD(ephemeral.PublicKey(), recipient, shared)
+
+	out := make([]byte, encodingLength, encodingLength+len(msg)+sealedBoxTagLength)
+	copy(out, ephemeral.public.key[:])
+
+	return aead.Seal(out, nonce, msg, nil), nil
+}
+
+// Open decrypts a box produced by Seal for the public key of recipient, and returns an error if it is invalid or was
+// not sealed for recipient.
+func Open(recipient *PrivateKey, box []byte) ([]byte, error) {
+	if len(box) < encodingLength+sealedBoxTagLength {
+		return nil, errSealedBox
+	}
+
+	ephemeral, err := ECDH().NewPublicKey(box[:encodingLength])
+	if err != nil {
+		return nil, errSealedBox
+	}
+
+	shared, err := recipient.ECDH(ephemeral)
+	if err != nil {
+		return nil, errSealedBox
+	}
+
+	aead, nonce := sealedBoxAEAD(ephemeral, recipient.PublicKey(), shared)
+
+	msg, err := aead.Open(nil, nonce, box[encodingLength:], nil)
+	if err != nil {
+		return nil, errSealedBox
+	}
+
+	return msg, nil
+}
+
+// sealedBoxAEAD derives the AEAD and nonce of a sealed box. Every box uses a fresh ephemeral key, so the key and nonce
+// pair is never reused.
+func sealedBoxAEAD(ephemeral, recipient *PublicKey, shared []byte) (cipher.AEAD, []byte) {
+	h := sha3.NewSHAKE256()
+	_, _ = h.Write([]byte(sealedBoxLabel))
+	_, _ = h.Write(ephemeral.key[:])
+	_, _ = h.Write(recipient.key[:])
+	_, _ = h.Write(shared)
+
+	var okm [sealedBoxKeyLength + sealedBoxNonceLength]byte
+	_, _ = h.Read(okm[:])
+
+	block, err := aes.NewCipher(okm[:sealedBoxKeyLength])
+	if err != nil {
+		panic(err)
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		panic(err)
+	}
+
+	return aead, okm[sealedBoxKeyLength:]
+}
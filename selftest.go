@@ -0,0 +1,80 @@
+// SPDX-License-Group: MIT
+//
+// Copyright (C) 2022 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package decaf448
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+)
+
+// Known answers for SelfTest. The element answers are the first two multiples of the generator, B[1] and B[2], and
+// the first element derivation vector of RFC 9496, Appendix A.2. The expansion answer is the expand_message_xof
+// vector of RFC 9380, Appendix K.6, for msg = "abc" and len_in_bytes = 0x20.
+const (
+	katGenerator       = "6666666666666666666666666666666666666666666666666666666633333333333333333333333333333333333333333333333333333333"
+	katGeneratorDouble = "c898eb4f87f97c564c6fd61fc7e49689314a1f818ec85eeb3bd5514ac816d38778f69ef347a89fca817e66defdedce178c7cc709b2116e75"
+
+	katUniformInput = "cbb8c991fd2f0b7e1913462d6463e4fd2ce4ccdd28274dc2ca1f4165d5ee6cdccea57be3416e166fd06718a31af45a2f" +
+		"8e987e301be59ae6673e963001dbbda80df47014a21a26d6c7eb4ebe0312aa6fffb8d1b26bc62ca40ed51f8057a635a02c2b8c83f48f" +
+		"a6a2d70f58a1185902c0"
+	katUniformOutput = "0c709c9607dbb01c94513358745b7c23953d03b33e39c7234e268d1d6e24f34014ccbc2216b965dd231d5327e591dc3c0e8844ccfd568848"
+
+	katExpandMessage = "abc"
+	katExpandDST     = "QUUX-V01-CS02-with-expander-SHAKE256"
+	katExpandOutput  = "b39e493867e2767216792abce1f2676c197c0692aed061560ead251821808e07"
+)
+
+var errSelfTest = errors.New("self-test failed")
+
+// SelfTest runs known-answer tests of encoding and decoding, scalar multiplication, element derivation and
+// expand_message_xof, and returns an error describing the first failure. It is meant for deployments that must check
+// the integrity of cryptographic code at startup.
+func SelfTest() (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%w: unexpected panic: %v", errSelfTest, r)
+		}
+	}()
+
+	// Re-encode without the cache seeded by decoding, so that the encoding is actually computed.
+	g, err := DecodeElement(mustHex(katGenerator))
+	if err != nil {
+		return fmt.Errorf("%w: decoding", errSelfTest)
+	}
+
+	g.encoded.Store(false)
+
+	if g.EncodeHex() != katGenerator || hex.EncodeToString(generatorEncoding) != katGenerator {
+		return fmt.Errorf("%w: encoding", errSelfTest)
+	}
+
+	if NewGroupElement().ScalarMult(NewScalar().SetUint64(2), g).EncodeHex() != katGeneratorDouble {
+		return fmt.Errorf("%w: scalar multiplication", errSelfTest)
+	}
+
+	if NewGroupElement().SetUniformBytes(mustHex(katUniformInput)).EncodeHex() != katUniformOutput {
+		return fmt.Errorf("%w: element derivation", errSelfTest)
+	}
+
+	if hex.EncodeToString(expandXOF([]byte(katExpandMessage), []byte(katExpandDST), 32)) != katExpandOutput {
+		return fmt.Errorf("%w: expand_message_xof", errSelfTest)
+	}
+
+	return nil
+}
+
+func mustHex(s string) []byte {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		panic(err)
+	}
+
+	return b
+}
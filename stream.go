@@ -0,0 +1,75 @@
+// SPDX-License-Group: MIT
+//
+// Copyright (C) 2022 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package decaf448
+
+import "io"
+
+// ReadElement reads exactly 56 bytes from r and decodes them into a new element.
+func ReadElement(r io.Reader) (*DecafElement, error) {
+	var buf [encodingLength]byte
+	if _, err := io.ReadFull(r, buf[:]); err != nil {
+		return nil, err
+	}
+
+	e := NewGroupElement()
+	if err := e.decode(buf[:]); err != nil {
+		return nil, err
+	}
+
+	return e, nil
+}
+
+// WriteElement writes the 56-byte encoding of e to w.
+func WriteElement(w io.Writer, e *DecafElement) error {
+	_, err := w.Write(e.Encode())
+	return err
+}
+
+// An Encoder writes a stream of element encodings to an underlying writer.
+type Encoder struct {
+	w io.Writer
+}
+
+// NewEncoder returns an encoder writing to w. It does not buffer: each call to Encode results in one write to w.
+func NewEncoder(w io.Writer) *Encoder {
+	return &Encoder{w: w}
+}
+
+// Encode writes the 56-byte encoding of each element in order.
+func (enc *Encoder) Encode(elements ...*DecafElement) error {
+	for _, e := range elements {
+		if _, err := enc.w.Write(e.cachedEncoding()[:]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// A Decoder reads and decodes a stream of element encodings from an underlying reader.
+type Decoder struct {
+	r   io.Reader
+	buf [encodingLength]byte
+}
+
+// NewDecoder returns a decoder reading from r. It reads exactly 56 bytes per element, and never reads ahead.
+func NewDecoder(r io.Reader) *Decoder {
+	return &Decoder{r: r}
+}
+
+// Decode reads the next encoding from the stream and decodes it into e. It returns io.EOF if the stream ends before
+// the encoding, io.ErrUnexpectedEOF if it ends within it, and an error if the encoding is invalid. e is left unchanged
+// if an error is returned.
+func (dec *Decoder) Decode(e *DecafElement) error {
+	if _, err := io.ReadFull(dec.r, dec.buf[:]); err != nil {
+		return err
+	}
+
+	return e.decode(dec.buf[:])
+}
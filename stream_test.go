@@ -0,0 +1,108 @@
+// SPDX-License-Group: MIT
+//
+// Copyright (C) 2022 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package decaf448_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/bytemare/decaf448"
+)
+
+func TestReadWriteElement(t *testing.T) {
+	var buf bytes.Buffer
+
+	e1, e2 := randomElement(t), randomElement(t)
+	for _, e := range []*decaf448.DecafElement{e1, e2} {
+		if err := decaf448.WriteElement(&buf, e); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, e := range []*decaf448.DecafElement{e1, e2} {
+		r, err := decaf448.ReadElement(&buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !bytes.Equal(e.Encode(), r.Encode()) {
+			t.Fatal("element differs after read")
+		}
+	}
+
+	if _, err := decaf448.ReadElement(&buf); !errors.Is(err, io.EOF) {
+		t.Fatalf("expected EOF, got %v", err)
+	}
+
+	if _, err := decaf448.ReadElement(bytes.NewReader(make([]byte, 55))); !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Fatalf("expected unexpected EOF, got %v", err)
+	}
+
+	invalid := e1.Encode()
+	invalid[0] |= 1
+
+	if _, err := decaf448.ReadElement(bytes.NewReader(invalid)); err == nil {
+		t.Fatal("expected error on invalid element")
+	}
+}
+
+func TestEncoderDecoder(t *testing.T) {
+	var buf bytes.Buffer
+
+	elements := []*decaf448.DecafElement{randomElement(t), randomElement(t), randomElement(t)}
+
+	enc := decaf448.NewEncoder(&buf)
+	if err := enc.Encode(elements[0]); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := enc.Encode(elements[1:]...); err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(buf.Bytes(), decaf448.EncodeElements(elements)[4:]) {
+		t.Fatal("unexpected stream encoding")
+	}
+
+	dec := decaf448.NewDecoder(&buf)
+	e := decaf448.NewGroupElement()
+
+	for _, expected := range elements {
+		if err := dec.Decode(e); err != nil {
+			t.Fatal(err)
+		}
+
+		if !bytes.Equal(e.Encode(), expected.Encode()) {
+			t.Fatal("element differs after decoding")
+		}
+	}
+
+	if err := dec.Decode(e); !errors.Is(err, io.EOF) {
+		t.Fatalf("expected EOF, got %v", err)
+	}
+
+	invalid := elements[0].Encode()
+	invalid[0] |= 1
+	before := e.Encode()
+
+	dec = decaf448.NewDecoder(io.MultiReader(bytes.NewReader(invalid), bytes.NewReader(make([]byte, 10))))
+	if err := dec.Decode(e); err == nil {
+		t.Fatal("expected error on invalid element")
+	}
+
+	if !bytes.Equal(e.Encode(), before) {
+		t.Fatal("element modified on decoding error")
+	}
+
+	if err := dec.Decode(e); !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Fatalf("expected unexpected EOF, got %v", err)
+	}
+}
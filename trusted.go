@@ -0,0 +1,89 @@
+// SPDX-License-Group: MIT
+//
+// Copyright (C) 2022 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package decaf448
+
+import (
+	"crypto/sha3"
+	"fmt"
+
+	"github.com/bytemare/decaf448/edwards448"
+	"github.com/bytemare/decaf448/internal/ct"
+)
+
+const (
+	// trustedLabel domain separates the integrity tag of trusted encodings.
+	trustedLabel = "decaf448_TrustedEncoding"
+
+	trustedCoordinatesLength = 4 * edwards448.ElementLength
+	trustedTagLength         = 32
+
+	// TrustedEncodingLength is the length of the output of EncodeTrusted.
+	TrustedEncodingLength = trustedCoordinatesLength + trustedTagLength
+)
+
+var (
+	errTrustedEncoding     = fmt.Errorf("%w: invalid trusted encoding", ErrInvalidFormat)
+	errTrustedNonCanonical = fmt.Errorf("%w: trusted encoding coordinate", ErrNonCanonical)
+)
+
+// EncodeTrusted returns the internal representation of e, its X, Y, Z and T coordinates as 56-byte little-endian
+// integers, followed by a 32-byte integrity tag. It is meant for local caches and precomputed tables, and can be
+// reloaded with DecodeTrusted without the square root computation of Decode.
+//
+// This is not a wire format: the output is not canonical, depends on the internal representation, and must only be
+// stored where it cannot be tampered with. Use Encode for anything else.
+func (e *DecafElement) EncodeTrusted() []byte {
+	out := make([]byte, 0, TrustedEncodingLength)
+	for _, c := range []*edwards448.Element{&e.p.X, &e.p.Y, &e.p.Z, &e.p.T} {
+		out = append(out, c.BytesLittle()...)
+	}
+
+	return append(out, trustedTag(out)...)
+}
+
+// DecodeTrusted sets e to the element encoded by EncodeTrusted in input. The integrity tag only detects accidental
+// corruption: it is not keyed, and anyone able to modify input can forge it. Beyond the tag, it only checks that the
+// coordinates are canonical and that Z is not zero, so input must come from a trusted source. e is left unchanged if
+// an error is returned.
+func (e *DecafElement) DecodeTrusted(input []byte) error {
+	if len(input) != TrustedEncodingLength {
+		return ErrInvalidLength
+	}
+
+	if ct.Equal(trustedTag(input[:trustedCoordinatesLength]), input[trustedCoordinatesLength:]) != 1 {
+		return errTrustedEncoding
+	}
+
+	var p edwards448.Point
+	for i, c := range []*edwards448.Element{&p.X, &p.Y, &p.Z, &p.T} {
+		if _, err := c.SetCanonicalBytes(input[i*edwards448.ElementLength : (i+1)*edwards448.ElementLength]); err != nil {
+			return errTrustedNonCanonical
+		}
+	}
+
+	if p.Z.IsZero() == 1 {
+		return errTrustedEncoding
+	}
+
+	e.p.Set(&p)
+	e.encoded.Store(false)
+
+	return nil
+}
+
+func trustedTag(coordinates []byte) []byte {
+	h := sha3.NewSHAKE256()
+	_, _ = h.Write([]byte(trustedLabel))
+	_, _ = h.Write(coordinates)
+
+	tag := make([]byte, trustedTagLength)
+	_, _ = h.Read(tag)
+
+	return tag
+}
@@ -0,0 +1,51 @@
+// SPDX-License-Group: MIT
+//
+// Copyright (C) 2022 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package decaf448_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/bytemare/decaf448"
+)
+
+func TestTrustedEncoding(t *testing.T) {
+	e := decaf448.NewAccumulator().Add(randomElement(t)).Add(randomElement(t)).Result()
+
+	encoded := e.EncodeTrusted()
+	if len(encoded) != decaf448.TrustedEncodingLength {
+		t.Fatalf("unexpected length %d", len(encoded))
+	}
+
+	d := decaf448.NewGroupElement()
+	if err := d.DecodeTrusted(encoded); err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(d.Encode(), e.Encode()) || !bytes.Equal(d.EncodeTrusted(), encoded) {
+		t.Fatal("element differs after trusted decoding")
+	}
+
+	for _, i := range []int{0, 100, 223, 224, 255} {
+		corrupted := bytes.Clone(encoded)
+		corrupted[i] ^= 1
+
+		if err := d.DecodeTrusted(corrupted); err == nil {
+			t.Fatalf("corruption at %d not detected", i)
+		}
+	}
+
+	if err := d.DecodeTrusted(encoded[:255]); err == nil {
+		t.Fatal("expected error on invalid length")
+	}
+
+	if !bytes.Equal(d.Encode(), e.Encode()) {
+		t.Fatal("element modified on decoding error")
+	}
+}
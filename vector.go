@@ -0,0 +1,59 @@
+// SPDX-License-Group: MIT
+//
+// Copyright (C) 2022 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package decaf448
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// vectorPrefixLength is the length of the element count prefix of an encoded vector.
+const vectorPrefixLength = 4
+
+var errVectorLength = fmt.Errorf("%w: invalid vector length", ErrInvalidLength)
+
+// EncodeElements returns the canonical encoding of the vector of elements, i.e. the element count as a 4-byte
+// big-endian integer followed by the 56-byte encoding of each element, in order.
+func EncodeElements(elements []*DecafElement) []byte {
+	out := make([]byte, vectorPrefixLength, vectorPrefixLength+len(elements)*encodingLength)
+	binary.BigEndian.PutUint32(out, uint32(len(elements)))
+
+	for _, e := range elements {
+		out = append(out, e.Encode()...)
+	}
+
+	return out
+}
+
+// DecodeElements decodes an encoded vector of elements as produced by EncodeElements. Parsing is strict: the input
+// length must exactly match the announced count, and every element must be a valid encoding.
+func DecodeElements(input []byte) ([]*DecafElement, error) {
+	if len(input) < vectorPrefixLength {
+		return nil, errVectorLength
+	}
+
+	n := uint64(binary.BigEndian.Uint32(input))
+	input = input[vectorPrefixLength:]
+
+	if uint64(len(input)) != n*encodingLength {
+		return nil, errVectorLength
+	}
+
+	elements := make([]*DecafElement, n)
+	for i := range elements {
+		e := NewGroupElement()
+		if err := e.decode(input[i*encodingLength : (i+1)*encodingLength]); err != nil {
+			return nil, err
+		}
+
+		elements[i] = e
+	}
+
+	return elements, nil
+}
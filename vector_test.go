@@ -0,0 +1,81 @@
+// SPDX-License-Group: MIT
+//
+// Copyright (C) 2022 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package decaf448_test
+
+import (
+	"bytes"
+	"crypto/rand"
+	"testing"
+
+	"github.com/bytemare/decaf448"
+)
+
+func randomElement(t testing.TB) *decaf448.DecafElement {
+	input := make([]byte, 112)
+	if _, err := rand.Read(input); err != nil {
+		t.Fatal(err)
+	}
+
+	return decaf448.NewGroupElement().OneWayMap(input)
+}
+
+func TestElementVector(t *testing.T) {
+	elements := make([]*decaf448.DecafElement, 5)
+	for i := range elements {
+		elements[i] = randomElement(t)
+	}
+
+	encoded := decaf448.EncodeElements(elements)
+	if len(encoded) != 4+5*56 {
+		t.Fatalf("unexpected vector length %d", len(encoded))
+	}
+
+	decoded, err := decaf448.DecodeElements(encoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(decoded) != len(elements) {
+		t.Fatalf("expected %d elements, got %d", len(elements), len(decoded))
+	}
+
+	for i := range elements {
+		if !bytes.Equal(elements[i].Encode(), decoded[i].Encode()) {
+			t.Fatalf("element %d differs after round trip", i)
+		}
+	}
+
+	empty, err := decaf448.DecodeElements(decaf448.EncodeElements(nil))
+	if err != nil || len(empty) != 0 {
+		t.Fatalf("empty vector round trip failed: %v", err)
+	}
+}
+
+func TestElementVectorStrict(t *testing.T) {
+	encoded := decaf448.EncodeElements([]*decaf448.DecafElement{randomElement(t), randomElement(t)})
+
+	for name, input := range map[string][]byte{
+		"short prefix":   encoded[:3],
+		"truncated":      encoded[:len(encoded)-1],
+		"trailing bytes": append(append([]byte{}, encoded...), 0),
+		"bad count":      append([]byte{0, 0, 0, 3}, encoded[4:]...),
+	} {
+		if _, err := decaf448.DecodeElements(input); err == nil {
+			t.Fatalf("%s: expected error", name)
+		}
+	}
+
+	// An odd (i.e. negative) field element is not a valid encoding.
+	invalid := append([]byte{}, encoded...)
+	invalid[4] |= 1
+
+	if _, err := decaf448.DecodeElements(invalid); err == nil {
+		t.Fatal("expected error on invalid element")
+	}
+}